@@ -0,0 +1,189 @@
+package msg
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const DefaultDocBaseURI = "urn:gobrick:error:"
+
+type CatalogEntry struct {
+	ID          string        `json:"id"`
+	Code        ErrorCode     `json:"code"`
+	HTTPStatus  int           `json:"http_status"`
+	Message     string        `json:"message"`
+	Description string        `json:"description"`
+	DocURI      string        `json:"doc_uri"`
+	Example     ErrorResponse `json:"example"`
+}
+
+// Catalog enumera os códigos e erros definidos que um serviço pode emitir.
+type Catalog struct {
+	mu      sync.RWMutex
+	baseURI string
+	entries map[string]CatalogEntry
+}
+
+func NewCatalog(baseURI string) *Catalog {
+	if baseURI == "" {
+		baseURI = DefaultDocBaseURI
+	}
+	c := &Catalog{baseURI: baseURI, entries: make(map[string]CatalogEntry)}
+	for _, entry := range codeEntries() {
+		c.MustRegister(entry)
+	}
+	return c
+}
+
+var DefaultCatalog = NewCatalog(DefaultDocBaseURI)
+
+func codeEntries() []CatalogEntry {
+	return []CatalogEntry{
+		{ID: string(CodeConflict), Code: CodeConflict, Message: "The resource conflicts with its current state.", Description: "The request conflicts with the current state of the target resource, such as a duplicate or a stale version."},
+		{ID: string(CodeInvalid), Code: CodeInvalid, Message: "The request is malformed or contains invalid parameters.", Description: "One or more input values failed validation."},
+		{ID: string(CodeNotFound), Code: CodeNotFound, Message: "The requested resource was not found.", Description: "The target resource does not exist or is not visible to the caller."},
+		{ID: string(CodeInternal), Code: CodeInternal, Message: "An unexpected internal error occurred.", Description: "An unexpected failure happened while processing the request."},
+		{ID: string(CodeUnauthorized), Code: CodeUnauthorized, Message: "You are not authorized to perform this action.", Description: "The caller is not authenticated."},
+		{ID: string(CodeForbidden), Code: CodeForbidden, Message: "You do not have permission to perform this action.", Description: "The caller is authenticated but lacks permission."},
+		{ID: string(CodeDomainViolation), Code: CodeDomainViolation, Message: "The operation violates a business rule.", Description: "The request is well-formed but breaks a domain invariant."},
+	}
+}
+
+func (c *Catalog) BaseURI() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.baseURI
+}
+
+func (c *Catalog) DocURI(id string) string {
+	return c.BaseURI() + id
+}
+
+func (c *Catalog) Register(entry CatalogEntry) error {
+	entry.ID = strings.TrimSpace(entry.ID)
+	if entry.ID == "" {
+		return NewValidationError(nil,
+			ValidationContext{Field: "id", Constraint: "required"}.ContextMap(),
+			"Catalog entry ID cannot be empty.",
+		)
+	}
+	if entry.Code == "" {
+		return NewValidationError(nil,
+			ValidationContext{Field: "code", Input: entry.ID, Constraint: "required"}.ContextMap(),
+			fmt.Sprintf("Catalog entry '%s' must define an error code.", entry.ID),
+		)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[entry.ID]; exists {
+		return NewMessageError(nil,
+			fmt.Sprintf("Catalog entry '%s' is already registered.", entry.ID),
+			CodeConflict,
+			ValidationContext{Field: "id", Input: entry.ID, Constraint: "unique"}.ContextMap(),
+		)
+	}
+
+	entry.HTTPStatus = (&MessageError{Code: entry.Code}).HTTPStatus()
+	entry.DocURI = c.baseURI + entry.ID
+	if entry.Example.Message == "" {
+		entry.Example.Message = entry.Message
+	}
+	entry.Example.StatusCode = entry.HTTPStatus
+	entry.Example.Code = string(entry.Code)
+	entry.Example.DocURI = entry.DocURI
+
+	c.entries[entry.ID] = entry
+	return nil
+}
+
+func (c *Catalog) MustRegister(entry CatalogEntry) {
+	if err := c.Register(entry); err != nil {
+		panic(err)
+	}
+}
+
+func (c *Catalog) Lookup(id string) (CatalogEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[id]
+	return entry, ok
+}
+
+// Resolve encontra a entrada mais específica para o erro: field.constraint,
+// target_type.source e, por fim, o próprio código.
+func (c *Catalog) Resolve(e *MessageError) (CatalogEntry, bool) {
+	if e == nil {
+		return CatalogEntry{}, false
+	}
+	for _, id := range candidateIDs(e) {
+		if entry, ok := c.Lookup(id); ok {
+			return entry, true
+		}
+	}
+	return CatalogEntry{}, false
+}
+
+func candidateIDs(e *MessageError) []string {
+	var ids []string
+	if v, err := PayloadFrom[ValidationContext](e); err == nil && v.Field != "" && v.Constraint != "" {
+		ids = append(ids, CatalogID(v.Field, v.Constraint))
+	}
+	if cv, err := PayloadFrom[ConversionContext](e); err == nil && cv.TargetType != "" && cv.Source != "" {
+		ids = append(ids, CatalogID(cv.TargetType, cv.Source))
+	}
+	return append(ids, string(e.Code))
+}
+
+// CatalogID monta o identificador estável usado pelas entradas dos pacotes de tipos.
+func CatalogID(subject, kind string) string {
+	return strings.ToLower(subject) + "." + strings.ToLower(kind)
+}
+
+func (c *Catalog) Entries() []CatalogEntry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entries := make([]CatalogEntry, 0, len(c.entries))
+	for _, entry := range c.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+	return entries
+}
+
+func (c *Catalog) ExportJSON() ([]byte, error) {
+	return json.MarshalIndent(c.Entries(), "", "  ")
+}
+
+func (c *Catalog) ExportMarkdown() (string, error) {
+	var b strings.Builder
+	b.WriteString("# Error Catalog\n")
+	for _, entry := range c.Entries() {
+		example, err := json.MarshalIndent(entry.Example, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", entry.ID)
+		fmt.Fprintf(&b, "- Code: `%s`\n", entry.Code)
+		fmt.Fprintf(&b, "- HTTP status: %d\n", entry.HTTPStatus)
+		fmt.Fprintf(&b, "- Doc URI: `%s`\n", entry.DocURI)
+		fmt.Fprintf(&b, "- Message: %s\n", entry.Message)
+		if entry.Description != "" {
+			fmt.Fprintf(&b, "\n%s\n", entry.Description)
+		}
+		fmt.Fprintf(&b, "\n```json\n%s\n```\n", example)
+	}
+	return b.String(), nil
+}
+
+func Register(entry CatalogEntry) error {
+	return DefaultCatalog.Register(entry)
+}
+
+func MustRegister(entry CatalogEntry) {
+	DefaultCatalog.MustRegister(entry)
+}
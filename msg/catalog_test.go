@@ -0,0 +1,136 @@
+package msg
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCatalog(t *testing.T) {
+	catalog := NewCatalog("https://docs.example.com/errors#")
+
+	for _, code := range []ErrorCode{CodeConflict, CodeInvalid, CodeNotFound, CodeInternal, CodeUnauthorized, CodeForbidden, CodeDomainViolation} {
+		entry, ok := catalog.Lookup(string(code))
+		require.True(t, ok, "code %s should be registered", code)
+		assert.Equal(t, code, entry.Code)
+		assert.Equal(t, "https://docs.example.com/errors#"+string(code), entry.DocURI)
+		assert.Equal(t, (&MessageError{Code: code}).HTTPStatus(), entry.HTTPStatus)
+	}
+
+	assert.Equal(t, DefaultDocBaseURI, NewCatalog("").BaseURI())
+}
+
+func TestCatalog_Register(t *testing.T) {
+	catalog := NewCatalog("")
+
+	t.Run("fills derived fields", func(t *testing.T) {
+		err := catalog.Register(CatalogEntry{
+			ID:      "order.total",
+			Code:    CodeDomainViolation,
+			Message: "Order total must be positive.",
+			Example: ErrorResponse{Context: map[string]any{KeyField: "total"}},
+		})
+		require.NoError(t, err)
+
+		entry, ok := catalog.Lookup("order.total")
+		require.True(t, ok)
+		assert.Equal(t, DefaultDocBaseURI+"order.total", entry.DocURI)
+		assert.Equal(t, http.StatusInternalServerError, entry.HTTPStatus)
+		assert.Equal(t, "Order total must be positive.", entry.Example.Message)
+		assert.Equal(t, string(CodeDomainViolation), entry.Example.Code)
+		assert.Equal(t, entry.DocURI, entry.Example.DocURI)
+	})
+
+	t.Run("rejects duplicates", func(t *testing.T) {
+		err := catalog.Register(CatalogEntry{ID: "order.total", Code: CodeInvalid})
+		var msgErr *MessageError
+		require.True(t, errors.As(err, &msgErr))
+		assert.Equal(t, CodeConflict, msgErr.Code)
+	})
+
+	t.Run("rejects missing id or code", func(t *testing.T) {
+		assert.Error(t, catalog.Register(CatalogEntry{Code: CodeInvalid}))
+		assert.Error(t, catalog.Register(CatalogEntry{ID: "no.code"}))
+	})
+
+	t.Run("MustRegister panics on error", func(t *testing.T) {
+		assert.Panics(t, func() { catalog.MustRegister(CatalogEntry{}) })
+	})
+}
+
+func TestCatalog_Resolve(t *testing.T) {
+	catalog := NewCatalog("")
+	catalog.MustRegister(CatalogEntry{ID: "email.format", Code: CodeInvalid, Message: "invalid email"})
+	catalog.MustRegister(CatalogEntry{ID: "email.json", Code: CodeInvalid, Message: "invalid email json"})
+
+	t.Run("by validation payload", func(t *testing.T) {
+		err := NewValidationError(nil, ValidationContext{Field: "email", Constraint: "format"}.ContextMap(), "bad")
+		entry, ok := catalog.Resolve(err)
+		require.True(t, ok)
+		assert.Equal(t, "email.format", entry.ID)
+	})
+
+	t.Run("by conversion payload", func(t *testing.T) {
+		err := NewValidationError(nil, ConversionContext{TargetType: "Email", Source: SourceJSON}.ContextMap(), "bad")
+		entry, ok := catalog.Resolve(err)
+		require.True(t, ok)
+		assert.Equal(t, "email.json", entry.ID)
+	})
+
+	t.Run("falls back to code", func(t *testing.T) {
+		entry, ok := catalog.Resolve(NewMessageError(nil, "missing", CodeNotFound, nil))
+		require.True(t, ok)
+		assert.Equal(t, string(CodeNotFound), entry.ID)
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		_, ok := catalog.Resolve(NewMessageError(nil, "x", ErrorCode("other"), nil))
+		assert.False(t, ok)
+	})
+}
+
+func TestCatalog_Export(t *testing.T) {
+	catalog := NewCatalog("")
+	catalog.MustRegister(CatalogEntry{ID: "email.format", Code: CodeInvalid, Message: "invalid email", Description: "Bad format."})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := catalog.ExportJSON()
+		require.NoError(t, err)
+
+		var entries []map[string]any
+		require.NoError(t, json.Unmarshal(data, &entries))
+		require.Len(t, entries, 8)
+		assert.Equal(t, "conflict", entries[0]["id"])
+		assert.Equal(t, "email.format", entries[2]["id"])
+		assert.Equal(t, float64(http.StatusBadRequest), entries[2]["http_status"])
+	})
+
+	t.Run("Markdown", func(t *testing.T) {
+		md, err := catalog.ExportMarkdown()
+		require.NoError(t, err)
+		assert.Contains(t, md, "# Error Catalog")
+		assert.Contains(t, md, "## email.format")
+		assert.Contains(t, md, "- HTTP status: 400")
+		assert.Contains(t, md, "Bad format.")
+		assert.Contains(t, md, `"doc_uri": "urn:gobrick:error:email.format"`)
+	})
+}
+
+func TestMessageError_ToResponseWithDocURI(t *testing.T) {
+	catalog := NewCatalog("")
+	detail := NewValidationError(nil, nil, "detail")
+	err := NewBadRequestError(nil, nil)
+	err.Details = []*MessageError{detail}
+
+	withoutDoc := err.ToResponse()
+	assert.Empty(t, withoutDoc.DocURI)
+
+	withDoc := err.ToResponse(WithDocURI(catalog))
+	assert.Equal(t, DefaultDocBaseURI+string(CodeInvalid), withDoc.DocURI)
+	require.Len(t, withDoc.Details, 1)
+	assert.Equal(t, DefaultDocBaseURI+string(CodeInvalid), withDoc.Details[0].DocURI)
+}
@@ -0,0 +1,186 @@
+package msg
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Conventional keys used in MessageError.Context across the whole library.
+const (
+	KeyField        = "field"
+	KeyInput        = "input"
+	KeyConstraint   = "constraint"
+	KeyLimit        = "limit"
+	KeyLength       = "length"
+	KeyNormalized   = "normalized"
+	KeyTargetType   = "target_type"
+	KeyReceivedType = "received_type"
+	KeySource       = "source"
+	KeyOperation    = "operation"
+	KeyRetryAfter   = "retry_after"
+	KeyAttempts     = "attempts"
+	KeyLocale       = "locale"
+	KeyLine         = "line"
+	KeyLayout       = "layout"
+	KeyAge          = "age"
+	KeyTimestamp    = "timestamp"
+)
+
+// Conventional values for KeySource.
+const (
+	SourceJSON = "json"
+	SourceText = "text"
+	SourceDB   = "db"
+	SourceCSV  = "csv"
+)
+
+var ErrContextSchema = errors.New("context does not match payload schema")
+
+// ContextPayload é um conjunto tipado de entradas que serializa para MessageError.Context.
+type ContextPayload interface {
+	ContextMap() map[string]any
+}
+
+// ContextDecoder lê de volta um payload tipado a partir de MessageError.Context.
+type ContextDecoder interface {
+	FromContext(ctx map[string]any) error
+}
+
+func (e *MessageError) WithPayload(p ContextPayload) *MessageError {
+	for k, v := range p.ContextMap() {
+		e.WithContext(k, v)
+	}
+	return e
+}
+
+// PayloadFrom decodifica o Context do erro no payload T, validando os tipos das chaves conhecidas.
+func PayloadFrom[T any, PT interface {
+	*T
+	ContextDecoder
+}](e *MessageError) (T, error) {
+	var payload T
+	if e == nil {
+		return payload, ErrContextSchema
+	}
+	if err := PT(&payload).FromContext(e.Context); err != nil {
+		return payload, err
+	}
+	return payload, nil
+}
+
+type ValidationContext struct {
+	Field      string
+	Input      any
+	Constraint string
+	Limit      any
+}
+
+func (c ValidationContext) ContextMap() map[string]any {
+	m := make(map[string]any)
+	if c.Field != "" {
+		m[KeyField] = c.Field
+	}
+	if c.Input != nil {
+		m[KeyInput] = c.Input
+	}
+	if c.Constraint != "" {
+		m[KeyConstraint] = c.Constraint
+	}
+	if c.Limit != nil {
+		m[KeyLimit] = c.Limit
+	}
+	return m
+}
+
+func (c *ValidationContext) FromContext(ctx map[string]any) error {
+	if err := requireAnyKey(ctx, KeyField, KeyInput, KeyConstraint, KeyLimit); err != nil {
+		return err
+	}
+	if err := readString(ctx, KeyField, &c.Field); err != nil {
+		return err
+	}
+	if err := readString(ctx, KeyConstraint, &c.Constraint); err != nil {
+		return err
+	}
+	c.Input = ctx[KeyInput]
+	c.Limit = ctx[KeyLimit]
+	return nil
+}
+
+type ConversionContext struct {
+	TargetType   string
+	ReceivedType string
+	Source       string
+	Input        any
+}
+
+func (c ConversionContext) ContextMap() map[string]any {
+	m := make(map[string]any)
+	if c.TargetType != "" {
+		m[KeyTargetType] = c.TargetType
+	}
+	if c.ReceivedType != "" {
+		m[KeyReceivedType] = c.ReceivedType
+	}
+	if c.Source != "" {
+		m[KeySource] = c.Source
+	}
+	if c.Input != nil {
+		m[KeyInput] = c.Input
+	}
+	return m
+}
+
+func (c *ConversionContext) FromContext(ctx map[string]any) error {
+	if err := requireAnyKey(ctx, KeyTargetType, KeyReceivedType, KeySource); err != nil {
+		return err
+	}
+	if err := readString(ctx, KeyTargetType, &c.TargetType); err != nil {
+		return err
+	}
+	if err := readString(ctx, KeyReceivedType, &c.ReceivedType); err != nil {
+		return err
+	}
+	if err := readString(ctx, KeySource, &c.Source); err != nil {
+		return err
+	}
+	c.Input = ctx[KeyInput]
+	return nil
+}
+
+type OperationContext struct {
+	Operation string
+}
+
+func (c OperationContext) ContextMap() map[string]any {
+	return map[string]any{KeyOperation: c.Operation}
+}
+
+func (c *OperationContext) FromContext(ctx map[string]any) error {
+	if err := requireAnyKey(ctx, KeyOperation); err != nil {
+		return err
+	}
+	return readString(ctx, KeyOperation, &c.Operation)
+}
+
+func requireAnyKey(ctx map[string]any, keys ...string) error {
+	for _, k := range keys {
+		if _, ok := ctx[k]; ok {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: none of the keys %v are present", ErrContextSchema, keys)
+}
+
+func readString(ctx map[string]any, key string, dst *string) error {
+	raw, ok := ctx[key]
+	if !ok {
+		return nil
+	}
+	s, ok := raw.(string)
+	if !ok {
+		return fmt.Errorf("%w: key '%s' must be a string, got %T", ErrContextSchema, key, raw)
+	}
+	*dst = s
+	return nil
+}
@@ -0,0 +1,82 @@
+package msg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationContext_ContextMap(t *testing.T) {
+	t.Run("serializes all fields with conventional keys", func(t *testing.T) {
+		ctx := ValidationContext{Field: "email", Input: "x", Constraint: "max_length", Limit: 254}.ContextMap()
+		assert.Equal(t, map[string]any{
+			KeyField:      "email",
+			KeyInput:      "x",
+			KeyConstraint: "max_length",
+			KeyLimit:      254,
+		}, ctx)
+	})
+
+	t.Run("omits zero fields", func(t *testing.T) {
+		ctx := ValidationContext{Field: "email"}.ContextMap()
+		assert.Equal(t, map[string]any{KeyField: "email"}, ctx)
+	})
+}
+
+func TestMessageError_WithPayload(t *testing.T) {
+	err := NewValidationError(nil, map[string]any{"request_id": "abc"}, "invalid")
+
+	returned := err.WithPayload(ConversionContext{TargetType: "Email", Source: SourceJSON})
+
+	assert.Same(t, err, returned)
+	assert.Equal(t, map[string]any{
+		"request_id":  "abc",
+		KeyTargetType: "Email",
+		KeySource:     SourceJSON,
+	}, err.Context)
+}
+
+func TestPayloadFrom(t *testing.T) {
+	t.Run("reads back a validation payload", func(t *testing.T) {
+		original := ValidationContext{Field: "phone", Input: "123", Constraint: "length", Limit: 13}
+		err := NewValidationError(nil, original.ContextMap(), "invalid phone")
+
+		payload, decodeErr := PayloadFrom[ValidationContext](err)
+
+		require.NoError(t, decodeErr)
+		assert.Equal(t, original, payload)
+	})
+
+	t.Run("reads back a conversion payload", func(t *testing.T) {
+		original := ConversionContext{TargetType: "UUID", ReceivedType: "int64", Source: SourceDB}
+		err := NewValidationError(nil, original.ContextMap(), "invalid uuid")
+
+		payload, decodeErr := PayloadFrom[ConversionContext](err)
+
+		require.NoError(t, decodeErr)
+		assert.Equal(t, original, payload)
+	})
+
+	t.Run("fails when no payload key is present", func(t *testing.T) {
+		err := NewValidationError(nil, map[string]any{"other": 1}, "invalid")
+
+		_, decodeErr := PayloadFrom[OperationContext](err)
+
+		assert.True(t, errors.Is(decodeErr, ErrContextSchema))
+	})
+
+	t.Run("fails when a key has the wrong type", func(t *testing.T) {
+		err := NewValidationError(nil, map[string]any{KeyField: 42}, "invalid")
+
+		_, decodeErr := PayloadFrom[ValidationContext](err)
+
+		assert.True(t, errors.Is(decodeErr, ErrContextSchema))
+	})
+
+	t.Run("fails for nil error", func(t *testing.T) {
+		_, decodeErr := PayloadFrom[ValidationContext](nil)
+		assert.True(t, errors.Is(decodeErr, ErrContextSchema))
+	})
+}
@@ -0,0 +1,186 @@
+package msg
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultDedupWindow = time.Minute
+	DefaultDedupBurst  = 1
+)
+
+type dedupState struct {
+	windowStart time.Time
+	emitted     int
+	suppressed  int
+	code        ErrorCode
+	message     string
+}
+
+// DedupLogger registra erros via slog limitando repetições com o mesmo fingerprint
+// a um número de ocorrências por janela e reportando as suprimidas.
+type DedupLogger struct {
+	logger *slog.Logger
+	window time.Duration
+	burst  int
+	now    func() time.Time
+
+	mu    sync.Mutex
+	state map[string]*dedupState
+}
+
+type DedupOption func(*DedupLogger)
+
+func WithDedupWindow(window time.Duration) DedupOption {
+	return func(l *DedupLogger) {
+		l.window = window
+	}
+}
+
+func WithDedupBurst(burst int) DedupOption {
+	return func(l *DedupLogger) {
+		l.burst = burst
+	}
+}
+
+func WithDedupClock(now func() time.Time) DedupOption {
+	return func(l *DedupLogger) {
+		l.now = now
+	}
+}
+
+func NewDedupLogger(logger *slog.Logger, opts ...DedupOption) *DedupLogger {
+	l := &DedupLogger{
+		logger: logger,
+		window: DefaultDedupWindow,
+		burst:  DefaultDedupBurst,
+		now:    time.Now,
+		state:  make(map[string]*dedupState),
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if l.logger == nil {
+		l.logger = slog.Default()
+	}
+	if l.window <= 0 {
+		l.window = DefaultDedupWindow
+	}
+	if l.burst < 1 {
+		l.burst = DefaultDedupBurst
+	}
+	return l
+}
+
+// Log registra o erro, a menos que seu fingerprint já tenha atingido o limite da janela atual.
+// Retorna true quando o erro foi efetivamente registrado.
+func (l *DedupLogger) Log(ctx context.Context, level slog.Level, err error) bool {
+	if err == nil {
+		return false
+	}
+	fp := Fingerprint(err)
+	now := l.now()
+
+	var msgErr *MessageError
+	isMsgErr := errors.As(err, &msgErr)
+	message := err.Error()
+	var code ErrorCode
+	if isMsgErr {
+		message, code = msgErr.Message, msgErr.Code
+	}
+
+	l.mu.Lock()
+	st, ok := l.state[fp]
+	if !ok {
+		st = &dedupState{windowStart: now, code: code, message: message}
+		l.state[fp] = st
+	}
+	var previouslySuppressed int
+	if now.Sub(st.windowStart) >= l.window {
+		previouslySuppressed = st.suppressed
+		st.windowStart = now
+		st.emitted = 0
+		st.suppressed = 0
+	}
+	if st.emitted >= l.burst {
+		st.suppressed++
+		l.mu.Unlock()
+		return false
+	}
+	st.emitted++
+	l.mu.Unlock()
+
+	attrs := []any{slog.String("fingerprint", fp)}
+	if isMsgErr {
+		attrs = append(attrs, slog.String("code", string(msgErr.Code)))
+		if len(msgErr.Context) > 0 {
+			attrs = append(attrs, slog.Any("context", msgErr.Context))
+		}
+		if msgErr.Err != nil {
+			attrs = append(attrs, slog.String("cause", msgErr.Err.Error()))
+		}
+	}
+	if previouslySuppressed > 0 {
+		attrs = append(attrs, slog.Int("suppressed", previouslySuppressed))
+	}
+	l.logger.Log(ctx, level, message, attrs...)
+	return true
+}
+
+// Flush reporta as contagens de erros suprimidos desde o último relatório e as zera.
+// Fingerprints cuja janela já terminou são descartados, limitando a memória ao número
+// de erros distintos vistos em uma janela.
+func (l *DedupLogger) Flush(ctx context.Context) {
+	type report struct {
+		fingerprint string
+		state       dedupState
+	}
+
+	now := l.now()
+	l.mu.Lock()
+	var reports []report
+	for fp, st := range l.state {
+		if st.suppressed > 0 {
+			reports = append(reports, report{fingerprint: fp, state: *st})
+			st.suppressed = 0
+		}
+		if now.Sub(st.windowStart) >= l.window {
+			delete(l.state, fp)
+		}
+	}
+	l.mu.Unlock()
+
+	sort.Slice(reports, func(i, j int) bool { return reports[i].fingerprint < reports[j].fingerprint })
+	for _, r := range reports {
+		l.logger.Log(ctx, slog.LevelWarn, "Repeated errors were suppressed.",
+			slog.String("fingerprint", r.fingerprint),
+			slog.String("code", string(r.state.code)),
+			slog.String("message", r.state.message),
+			slog.Int("suppressed", r.state.suppressed),
+		)
+	}
+}
+
+// Run chama Flush periodicamente até o contexto ser cancelado. Um intervalo não
+// positivo usa a janela de deduplicação.
+func (l *DedupLogger) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = l.window
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			l.Flush(context.Background())
+			return
+		case <-ticker.C:
+			l.Flush(ctx)
+		}
+	}
+}
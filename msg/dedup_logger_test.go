@@ -0,0 +1,129 @@
+package msg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDedupLogger(buf *bytes.Buffer, now *time.Time, opts ...DedupOption) *DedupLogger {
+	logger := slog.New(slog.NewJSONHandler(buf, nil))
+	base := []DedupOption{WithDedupClock(func() time.Time { return *now }), WithDedupWindow(time.Minute)}
+	return NewDedupLogger(logger, append(base, opts...)...)
+}
+
+func decodeLogLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestDedupLogger_Log(t *testing.T) {
+	var buf bytes.Buffer
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newTestDedupLogger(&buf, &now)
+	ctx := context.Background()
+
+	err := NewInternalError(nil, map[string]any{"request_id": "abc"})
+
+	assert.True(t, l.Log(ctx, slog.LevelError, err))
+	assert.False(t, l.Log(ctx, slog.LevelError, err))
+	assert.False(t, l.Log(ctx, slog.LevelError, err))
+	assert.True(t, l.Log(ctx, slog.LevelError, NewForbiddenError(nil, nil)), "different fingerprints are not deduplicated")
+	assert.False(t, l.Log(ctx, slog.LevelError, nil))
+
+	now = now.Add(time.Minute)
+	assert.True(t, l.Log(ctx, slog.LevelError, err), "a new window allows logging again")
+
+	lines := decodeLogLines(t, &buf)
+	require.Len(t, lines, 3)
+	assert.Equal(t, err.Message, lines[0]["msg"])
+	assert.Equal(t, err.Fingerprint(), lines[0]["fingerprint"])
+	assert.Equal(t, string(CodeInternal), lines[0]["code"])
+	assert.Equal(t, float64(2), lines[2]["suppressed"])
+}
+
+func TestDedupLogger_Burst(t *testing.T) {
+	var buf bytes.Buffer
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newTestDedupLogger(&buf, &now, WithDedupBurst(2))
+
+	err := NewInternalError(nil, nil)
+	assert.True(t, l.Log(context.Background(), slog.LevelError, err))
+	assert.True(t, l.Log(context.Background(), slog.LevelError, err))
+	assert.False(t, l.Log(context.Background(), slog.LevelError, err))
+}
+
+func TestDedupLogger_Flush(t *testing.T) {
+	var buf bytes.Buffer
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newTestDedupLogger(&buf, &now)
+	ctx := context.Background()
+
+	err := NewInternalError(nil, nil)
+	for i := 0; i < 5; i++ {
+		l.Log(ctx, slog.LevelError, err)
+	}
+	buf.Reset()
+
+	l.Flush(ctx)
+	lines := decodeLogLines(t, &buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "WARN", lines[0]["level"])
+	assert.Equal(t, err.Fingerprint(), lines[0]["fingerprint"])
+	assert.Equal(t, float64(4), lines[0]["suppressed"])
+
+	buf.Reset()
+	l.Flush(ctx)
+	assert.Empty(t, buf.String(), "counts are reset after flushing")
+}
+
+func TestDedupLogger_FlushEvictsExpiredFingerprints(t *testing.T) {
+	var buf bytes.Buffer
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newTestDedupLogger(&buf, &now)
+	ctx := context.Background()
+
+	for i := 0; i < 100; i++ {
+		l.Log(ctx, slog.LevelError, NewInternalError(nil, map[string]any{KeyField: fmt.Sprintf("field_%d", i)}))
+	}
+	repeated := NewForbiddenError(nil, nil)
+	l.Log(ctx, slog.LevelError, repeated)
+	l.Log(ctx, slog.LevelError, repeated)
+
+	l.Flush(ctx)
+	assert.Len(t, l.state, 101, "fingerprints inside the window are kept")
+
+	now = now.Add(time.Minute)
+	buf.Reset()
+	l.Flush(ctx)
+	assert.Empty(t, l.state)
+	assert.Empty(t, buf.String(), "suppressed counts were already reported")
+	assert.True(t, l.Log(ctx, slog.LevelError, repeated))
+}
+
+func TestDedupLogger_RunWithNonPositiveInterval(t *testing.T) {
+	var buf bytes.Buffer
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newTestDedupLogger(&buf, &now)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NotPanics(t, func() { l.Run(ctx, 0) })
+}
@@ -89,18 +89,42 @@ type ErrorResponse struct {
 	Message    string          `json:"message"`
 	Code       string          `json:"code,omitempty"`
 	Context    map[string]any  `json:"context,omitempty"`
+	DocURI     string          `json:"doc_uri,omitempty"`
 	Details    []ErrorResponse `json:"details,omitempty"`
 }
 
-func (e *MessageError) ToResponse() ErrorResponse {
+type responseOptions struct {
+	catalog *Catalog
+}
+
+type ResponseOption func(*responseOptions)
+
+// WithDocURI inclui na resposta o URI de documentação resolvido no catálogo informado.
+func WithDocURI(catalog *Catalog) ResponseOption {
+	return func(o *responseOptions) {
+		o.catalog = catalog
+	}
+}
+
+func (e *MessageError) ToResponse(opts ...ResponseOption) ErrorResponse {
+	var options responseOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	resp := ErrorResponse{
 		StatusCode: e.HTTPStatus(),
 		Message:    e.Message,
 		Code:       string(e.Code),
 		Context:    e.Context,
 	}
+	if options.catalog != nil {
+		if entry, ok := options.catalog.Resolve(e); ok {
+			resp.DocURI = entry.DocURI
+		}
+	}
 	for _, detail := range e.Details {
-		resp.Details = append(resp.Details, detail.ToResponse())
+		resp.Details = append(resp.Details, detail.ToResponse(opts...))
 	}
 	return resp
 }
@@ -0,0 +1,62 @@
+package msg
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Chaves do Context que identificam a origem do erro; as demais (entradas, IDs)
+// são consideradas voláteis e não participam do fingerprint.
+var fingerprintContextKeys = []string{
+	KeyField,
+	KeyConstraint,
+	KeyTargetType,
+	KeyReceivedType,
+	KeySource,
+	KeyOperation,
+}
+
+var (
+	quotedValuePattern = regexp.MustCompile(`'[^']*'|"[^"]*"`)
+	numberPattern      = regexp.MustCompile(`\d+`)
+)
+
+// MessageTemplate remove do texto os valores variáveis (trechos entre aspas e números).
+func MessageTemplate(message string) string {
+	template := quotedValuePattern.ReplaceAllString(message, "'?'")
+	return numberPattern.ReplaceAllString(template, "#")
+}
+
+func (e *MessageError) Fingerprint() string {
+	var b strings.Builder
+	b.WriteString(string(e.Code))
+	b.WriteString("|")
+	b.WriteString(MessageTemplate(e.Message))
+	for _, key := range fingerprintContextKeys {
+		if v, ok := e.Context[key]; ok {
+			fmt.Fprintf(&b, "|%s=%v", key, v)
+		}
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&b, "|cause=%T", e.Err)
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Fingerprint calcula o fingerprint de qualquer erro, usando o primeiro MessageError da cadeia.
+func Fingerprint(err error) string {
+	if err == nil {
+		return ""
+	}
+	var msgErr *MessageError
+	if errors.As(err, &msgErr) {
+		return msgErr.Fingerprint()
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%T|%s", err, MessageTemplate(err.Error()))))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,53 @@
+package msg
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageTemplate(t *testing.T) {
+	assert.Equal(t, "Email address '?' has an invalid format.", MessageTemplate("Email address 'a@b' has an invalid format."))
+	assert.Equal(t, "Value # is out of range for Version (int).", MessageTemplate("Value 123 is out of range for Version (int)."))
+}
+
+func TestMessageError_Fingerprint(t *testing.T) {
+	newErr := func(input, requestID string) *MessageError {
+		return NewValidationError(nil,
+			ValidationContext{Field: "email", Input: input, Constraint: "format"}.ContextMap(),
+			fmt.Sprintf("Email address '%s' has an invalid format.", input),
+		).WithContext("request_id", requestID)
+	}
+
+	t.Run("ignores volatile values", func(t *testing.T) {
+		assert.Equal(t, newErr("a", "1").Fingerprint(), newErr("b", "2").Fingerprint())
+	})
+
+	t.Run("differs by code", func(t *testing.T) {
+		other := newErr("a", "1")
+		other.Code = CodeConflict
+		assert.NotEqual(t, newErr("a", "1").Fingerprint(), other.Fingerprint())
+	})
+
+	t.Run("differs by origin", func(t *testing.T) {
+		other := newErr("a", "1").WithContext(KeyField, "backup_email")
+		assert.NotEqual(t, newErr("a", "1").Fingerprint(), other.Fingerprint())
+	})
+
+	t.Run("differs by cause type", func(t *testing.T) {
+		withCause := newErr("a", "1")
+		withCause.Err = errors.New("cause")
+		assert.NotEqual(t, newErr("a", "1").Fingerprint(), withCause.Fingerprint())
+	})
+
+	assert.Len(t, newErr("a", "1").Fingerprint(), 16)
+}
+
+func TestFingerprint(t *testing.T) {
+	msgErr := NewInternalError(nil, nil)
+	assert.Equal(t, msgErr.Fingerprint(), Fingerprint(fmt.Errorf("wrapped: %w", msgErr)))
+	assert.Equal(t, Fingerprint(errors.New("timeout after 3s")), Fingerprint(errors.New("timeout after 5s")))
+	assert.Empty(t, Fingerprint(nil))
+}
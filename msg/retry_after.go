@@ -0,0 +1,26 @@
+package msg
+
+import (
+	"errors"
+	"time"
+)
+
+// WithRetryAfter indica ao chamador quanto tempo esperar antes de tentar novamente.
+func (e *MessageError) WithRetryAfter(d time.Duration) *MessageError {
+	return e.WithContext(KeyRetryAfter, d)
+}
+
+// RetryAfter procura na cadeia de erros a dica de espera registrada por WithRetryAfter.
+func RetryAfter(err error) (time.Duration, bool) {
+	var msgErr *MessageError
+	for err != nil {
+		if !errors.As(err, &msgErr) {
+			return 0, false
+		}
+		if d, ok := msgErr.Context[KeyRetryAfter].(time.Duration); ok && d >= 0 {
+			return d, true
+		}
+		err = msgErr.Err
+	}
+	return 0, false
+}
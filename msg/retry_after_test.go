@@ -0,0 +1,35 @@
+package msg
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryAfter(t *testing.T) {
+	t.Run("reads hint from the error", func(t *testing.T) {
+		err := NewInternalError(nil, nil).WithRetryAfter(3 * time.Second)
+		d, ok := RetryAfter(err)
+		assert.True(t, ok)
+		assert.Equal(t, 3*time.Second, d)
+	})
+
+	t.Run("reads hint from a wrapped error", func(t *testing.T) {
+		inner := NewInternalError(nil, nil).WithRetryAfter(time.Second)
+		outer := NewMessageError(inner, "outer", CodeInternal, nil)
+		d, ok := RetryAfter(fmt.Errorf("call failed: %w", outer))
+		assert.True(t, ok)
+		assert.Equal(t, time.Second, d)
+	})
+
+	t.Run("no hint", func(t *testing.T) {
+		_, ok := RetryAfter(NewInternalError(nil, map[string]any{KeyRetryAfter: "soon"}))
+		assert.False(t, ok)
+		_, ok = RetryAfter(fmt.Errorf("plain"))
+		assert.False(t, ok)
+		_, ok = RetryAfter(nil)
+		assert.False(t, ok)
+	})
+}
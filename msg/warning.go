@@ -0,0 +1,120 @@
+package msg
+
+const (
+	CodeNormalized ErrorCode = "normalized"
+	CodeDeprecated ErrorCode = "deprecated"
+)
+
+// Warning é um aviso não fatal que acompanha um resultado bem-sucedido.
+type Warning struct {
+	Message string
+	Code    ErrorCode
+	Context map[string]any
+}
+
+func NewWarning(message string, code ErrorCode, context map[string]any) *Warning {
+	return &Warning{
+		Message: message,
+		Code:    code,
+		Context: context,
+	}
+}
+
+func NewNormalizedWarning(context map[string]any, message string) *Warning {
+	return NewWarning(message, CodeNormalized, context)
+}
+
+func (w *Warning) WithContext(key string, value any) *Warning {
+	if w.Context == nil {
+		w.Context = make(map[string]any)
+	}
+	w.Context[key] = value
+	return w
+}
+
+func (w *Warning) WithPayload(p ContextPayload) *Warning {
+	for k, v := range p.ContextMap() {
+		w.WithContext(k, v)
+	}
+	return w
+}
+
+type WarningResponse struct {
+	Message string         `json:"message"`
+	Code    string         `json:"code,omitempty"`
+	Context map[string]any `json:"context,omitempty"`
+}
+
+func (w *Warning) ToResponse() WarningResponse {
+	return WarningResponse{
+		Message: w.Message,
+		Code:    string(w.Code),
+		Context: w.Context,
+	}
+}
+
+type Warnings []*Warning
+
+func (ws *Warnings) Add(warnings ...*Warning) {
+	for _, w := range warnings {
+		if w != nil {
+			*ws = append(*ws, w)
+		}
+	}
+}
+
+func (ws Warnings) IsEmpty() bool {
+	return len(ws) == 0
+}
+
+func (ws Warnings) HasCode(code ErrorCode) bool {
+	for _, w := range ws {
+		if w.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func (ws Warnings) ToResponse() []WarningResponse {
+	if len(ws) == 0 {
+		return nil
+	}
+	resp := make([]WarningResponse, 0, len(ws))
+	for _, w := range ws {
+		resp = append(resp, w.ToResponse())
+	}
+	return resp
+}
+
+// Result carrega o valor de uma operação bem-sucedida junto com seus avisos.
+type Result[T any] struct {
+	Value    T
+	Warnings Warnings
+}
+
+func NewResult[T any](value T, warnings ...*Warning) Result[T] {
+	r := Result[T]{Value: value}
+	r.Warnings.Add(warnings...)
+	return r
+}
+
+func (r *Result[T]) AddWarning(warnings ...*Warning) {
+	r.Warnings.Add(warnings...)
+}
+
+func (r Result[T]) HasWarnings() bool {
+	return !r.Warnings.IsEmpty()
+}
+
+type Envelope[T any] struct {
+	Data     T                 `json:"data"`
+	Warnings []WarningResponse `json:"warnings,omitempty"`
+}
+
+func (r Result[T]) ToEnvelope() Envelope[T] {
+	return Envelope[T]{
+		Data:     r.Value,
+		Warnings: r.Warnings.ToResponse(),
+	}
+}
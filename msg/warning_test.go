@@ -0,0 +1,60 @@
+package msg
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWarning(t *testing.T) {
+	w := NewWarning("value was trimmed", CodeNormalized, nil)
+
+	assert.Equal(t, "value was trimmed", w.Message)
+	assert.Equal(t, CodeNormalized, w.Code)
+	assert.Nil(t, w.Context)
+
+	returned := w.WithContext(KeyField, "name").WithPayload(ValidationContext{Input: " Ana "})
+	assert.Same(t, w, returned)
+	assert.Equal(t, map[string]any{KeyField: "name", KeyInput: " Ana "}, w.Context)
+}
+
+func TestWarnings(t *testing.T) {
+	var ws Warnings
+	assert.True(t, ws.IsEmpty())
+	assert.Nil(t, ws.ToResponse())
+
+	ws.Add(NewNormalizedWarning(nil, "normalized"), nil, NewWarning("old field", CodeDeprecated, nil))
+
+	require.Len(t, ws, 2, "nil warnings should be ignored")
+	assert.True(t, ws.HasCode(CodeDeprecated))
+	assert.False(t, ws.HasCode(CodeInvalid))
+	assert.Equal(t, []WarningResponse{
+		{Message: "normalized", Code: string(CodeNormalized)},
+		{Message: "old field", Code: string(CodeDeprecated)},
+	}, ws.ToResponse())
+}
+
+func TestResult_ToEnvelope(t *testing.T) {
+	t.Run("renders data and warnings", func(t *testing.T) {
+		r := NewResult("ana@example.com")
+		assert.False(t, r.HasWarnings())
+
+		r.AddWarning(NewNormalizedWarning(map[string]any{KeyField: "email"}, "Email was lower-cased."))
+		assert.True(t, r.HasWarnings())
+
+		data, err := json.Marshal(r.ToEnvelope())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"data": "ana@example.com",
+			"warnings": [{"message": "Email was lower-cased.", "code": "normalized", "context": {"field": "email"}}]
+		}`, string(data))
+	})
+
+	t.Run("omits warnings when empty", func(t *testing.T) {
+		data, err := json.Marshal(NewResult(map[string]int{"total": 3}).ToEnvelope())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data": {"total": 3}}`, string(data))
+	})
+}
@@ -0,0 +1,236 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+const (
+	DefaultMaxAttempts  = 3
+	DefaultInitialDelay = 100 * time.Millisecond
+	DefaultMaxDelay     = 10 * time.Second
+	DefaultMultiplier   = 2.0
+	DefaultJitter       = 0.2
+)
+
+// Sleeper espera pela duração informada ou até o contexto ser cancelado.
+type Sleeper func(ctx context.Context, d time.Duration) error
+
+// Classifier decide se um erro deve ser tentado novamente.
+type Classifier func(err error) bool
+
+type Executor struct {
+	maxAttempts  int
+	initialDelay time.Duration
+	maxDelay     time.Duration
+	multiplier   float64
+	jitter       float64
+	sleeper      Sleeper
+	classifier   Classifier
+	random       func() float64
+}
+
+type Option func(*Executor)
+
+func WithMaxAttempts(n int) Option {
+	return func(e *Executor) {
+		e.maxAttempts = n
+	}
+}
+
+func WithBackoff(initial, max time.Duration, multiplier float64) Option {
+	return func(e *Executor) {
+		e.initialDelay = initial
+		e.maxDelay = max
+		e.multiplier = multiplier
+	}
+}
+
+// WithJitter define a fração (0 a 1) de variação aleatória aplicada a cada espera.
+func WithJitter(fraction float64) Option {
+	return func(e *Executor) {
+		e.jitter = fraction
+	}
+}
+
+func WithSleeper(s Sleeper) Option {
+	return func(e *Executor) {
+		e.sleeper = s
+	}
+}
+
+func WithClassifier(c Classifier) Option {
+	return func(e *Executor) {
+		e.classifier = c
+	}
+}
+
+// WithRandom substitui a fonte de aleatoriedade do jitter; deve retornar valores em [0, 1).
+func WithRandom(r func() float64) Option {
+	return func(e *Executor) {
+		e.random = r
+	}
+}
+
+func NewExecutor(opts ...Option) (*Executor, error) {
+	e := &Executor{
+		maxAttempts:  DefaultMaxAttempts,
+		initialDelay: DefaultInitialDelay,
+		maxDelay:     DefaultMaxDelay,
+		multiplier:   DefaultMultiplier,
+		jitter:       DefaultJitter,
+		sleeper:      SleepContext,
+		classifier:   IsRetryable,
+		random:       rand.Float64,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	if err := e.validate(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func MustNewExecutor(opts ...Option) *Executor {
+	e, err := NewExecutor(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+func (e *Executor) validate() error {
+	switch {
+	case e.maxAttempts < 1:
+		return msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "max_attempts", Input: e.maxAttempts, Constraint: "min", Limit: 1}.ContextMap(),
+			fmt.Sprintf("Retry max attempts must be at least 1, got %d.", e.maxAttempts),
+		)
+	case e.initialDelay < 0 || e.maxDelay < e.initialDelay:
+		return msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "delay", Input: e.initialDelay, Constraint: "range", Limit: e.maxDelay}.ContextMap(),
+			fmt.Sprintf("Retry delays must satisfy 0 <= initial (%s) <= max (%s).", e.initialDelay, e.maxDelay),
+		)
+	case e.multiplier < 1:
+		return msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "multiplier", Input: e.multiplier, Constraint: "min", Limit: 1}.ContextMap(),
+			fmt.Sprintf("Retry backoff multiplier must be at least 1, got %g.", e.multiplier),
+		)
+	case e.jitter < 0 || e.jitter > 1:
+		return msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "jitter", Input: e.jitter, Constraint: "range", Limit: 1}.ContextMap(),
+			fmt.Sprintf("Retry jitter must be between 0 and 1, got %g.", e.jitter),
+		)
+	case e.sleeper == nil || e.classifier == nil || e.random == nil:
+		return msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "executor", Constraint: "required"}.ContextMap(),
+			"Retry sleeper, classifier and random source cannot be nil.",
+		)
+	}
+	return nil
+}
+
+// IsRetryable é o classificador padrão: desiste imediatamente de erros de entrada,
+// permissão, regra de domínio e cancelamento de contexto.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var msgErr *msg.MessageError
+	if errors.As(err, &msgErr) {
+		switch msgErr.Code {
+		case msg.CodeInvalid, msg.CodeForbidden, msg.CodeDomainViolation:
+			return false
+		}
+	}
+	return true
+}
+
+func SleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Backoff retorna a espera antes da próxima tentativa, sem considerar dicas do erro.
+func (e *Executor) Backoff(attempt int) time.Duration {
+	delay := float64(e.initialDelay)
+	for i := 1; i < attempt; i++ {
+		delay *= e.multiplier
+		if delay >= float64(e.maxDelay) {
+			delay = float64(e.maxDelay)
+			break
+		}
+	}
+	if e.jitter > 0 {
+		delay *= 1 + e.jitter*(2*e.random()-1)
+	}
+	if delay > float64(e.maxDelay) {
+		delay = float64(e.maxDelay)
+	}
+	return time.Duration(delay)
+}
+
+func (e *Executor) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, err := Do(ctx, e, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	})
+	return err
+}
+
+// Do executa fn até obter sucesso, um erro não recuperável, esgotar as tentativas
+// ou o contexto ser cancelado. O último erro de fn é retornado sem alteração, exceto
+// no cancelamento: aí o erro é interno e envolve ctx.Err() e o último erro de fn.
+// Dicas de retry-after do erro são respeitadas integralmente; a espera máxima do
+// executor limita apenas o backoff calculado, e o contexto continua interrompendo a espera.
+func Do[T any](ctx context.Context, e *Executor, fn func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+	var lastErr error
+	for attempt := 1; attempt <= e.maxAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, aborted(err, lastErr, attempt-1)
+		}
+
+		value, err := fn(ctx)
+		if err == nil {
+			return value, nil
+		}
+		lastErr = err
+
+		if attempt == e.maxAttempts || !e.classifier(err) {
+			return zero, err
+		}
+
+		delay, ok := msg.RetryAfter(err)
+		if !ok {
+			delay = e.Backoff(attempt)
+		}
+		if err := e.sleeper(ctx, delay); err != nil {
+			return zero, aborted(err, lastErr, attempt)
+		}
+	}
+	return zero, lastErr
+}
+
+func aborted(ctxErr, lastErr error, attempts int) error {
+	return msg.NewInternalError(errors.Join(ctxErr, lastErr),
+		msg.OperationContext{Operation: "retry"}.ContextMap(),
+	).WithContext(msg.KeyAttempts, attempts)
+}
@@ -0,0 +1,232 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/retry"
+)
+
+type recordingSleeper struct {
+	delays []time.Duration
+}
+
+func (s *recordingSleeper) Sleep(ctx context.Context, d time.Duration) error {
+	s.delays = append(s.delays, d)
+	return ctx.Err()
+}
+
+func newTestExecutor(t *testing.T, sleeper *recordingSleeper, opts ...retry.Option) *retry.Executor {
+	t.Helper()
+	base := []retry.Option{
+		retry.WithSleeper(sleeper.Sleep),
+		retry.WithBackoff(100*time.Millisecond, time.Second, 2),
+		retry.WithJitter(0),
+	}
+	e, err := retry.NewExecutor(append(base, opts...)...)
+	require.NoError(t, err)
+	return e
+}
+
+func TestNewExecutor_Validation(t *testing.T) {
+	testCases := []struct {
+		name string
+		opt  retry.Option
+	}{
+		{"zero attempts", retry.WithMaxAttempts(0)},
+		{"max below initial", retry.WithBackoff(time.Second, time.Millisecond, 2)},
+		{"multiplier below one", retry.WithBackoff(time.Millisecond, time.Second, 0.5)},
+		{"jitter above one", retry.WithJitter(1.5)},
+		{"nil sleeper", retry.WithSleeper(nil)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := retry.NewExecutor(tc.opt)
+			var msgErr *msg.MessageError
+			require.True(t, errors.As(err, &msgErr))
+			assert.Equal(t, msg.CodeInvalid, msgErr.Code)
+		})
+	}
+
+	assert.Panics(t, func() { retry.MustNewExecutor(retry.WithMaxAttempts(-1)) })
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.False(t, retry.IsRetryable(nil))
+	assert.False(t, retry.IsRetryable(msg.NewValidationError(nil, nil, "bad")))
+	assert.False(t, retry.IsRetryable(msg.NewForbiddenError(nil, nil)))
+	assert.False(t, retry.IsRetryable(msg.NewDomainError(nil, "rule", nil)))
+	assert.False(t, retry.IsRetryable(context.Canceled))
+	assert.True(t, retry.IsRetryable(msg.NewInternalError(nil, nil)))
+	assert.True(t, retry.IsRetryable(errors.New("connection reset")))
+}
+
+func TestExecutor_Do(t *testing.T) {
+	t.Run("retries with exponential backoff until success", func(t *testing.T) {
+		sleeper := &recordingSleeper{}
+		e := newTestExecutor(t, sleeper, retry.WithMaxAttempts(4))
+
+		calls := 0
+		err := e.Do(context.Background(), func(ctx context.Context) error {
+			calls++
+			if calls < 4 {
+				return msg.NewInternalError(nil, nil)
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, calls)
+		assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond}, sleeper.delays)
+	})
+
+	t.Run("returns last error when attempts are exhausted", func(t *testing.T) {
+		sleeper := &recordingSleeper{}
+		e := newTestExecutor(t, sleeper, retry.WithMaxAttempts(3))
+		last := errors.New("still down")
+
+		calls := 0
+		err := e.Do(context.Background(), func(ctx context.Context) error {
+			calls++
+			return last
+		})
+
+		assert.Same(t, last, err)
+		assert.Equal(t, 3, calls)
+		assert.Len(t, sleeper.delays, 2)
+	})
+
+	t.Run("gives up immediately on non-retryable codes", func(t *testing.T) {
+		for _, code := range []msg.ErrorCode{msg.CodeInvalid, msg.CodeForbidden, msg.CodeDomainViolation} {
+			sleeper := &recordingSleeper{}
+			e := newTestExecutor(t, sleeper, retry.WithMaxAttempts(5))
+
+			calls := 0
+			err := e.Do(context.Background(), func(ctx context.Context) error {
+				calls++
+				return msg.NewMessageError(nil, "no", code, nil)
+			})
+
+			require.Error(t, err)
+			assert.Equal(t, 1, calls, "code %s should not be retried", code)
+			assert.Empty(t, sleeper.delays)
+		}
+	})
+
+	t.Run("honours retry-after hint", func(t *testing.T) {
+		sleeper := &recordingSleeper{}
+		e := newTestExecutor(t, sleeper)
+
+		calls := 0
+		err := e.Do(context.Background(), func(ctx context.Context) error {
+			calls++
+			if calls == 1 {
+				return msg.NewInternalError(nil, nil).WithRetryAfter(500 * time.Millisecond)
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{500 * time.Millisecond}, sleeper.delays)
+	})
+
+	t.Run("honours retry-after hint above max delay", func(t *testing.T) {
+		sleeper := &recordingSleeper{}
+		e := newTestExecutor(t, sleeper)
+
+		calls := 0
+		err := e.Do(context.Background(), func(ctx context.Context) error {
+			calls++
+			switch calls {
+			case 1:
+				return msg.NewInternalError(nil, nil).WithRetryAfter(time.Hour)
+			case 2:
+				return msg.NewInternalError(nil, nil)
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{time.Hour, 200 * time.Millisecond}, sleeper.delays, "max delay only caps the computed backoff")
+	})
+
+	t.Run("stops on context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		sleeper := &recordingSleeper{}
+		e := newTestExecutor(t, sleeper, retry.WithMaxAttempts(5))
+
+		calls := 0
+		err := e.Do(ctx, func(ctx context.Context) error {
+			calls++
+			cancel()
+			return errors.New("down")
+		})
+
+		assert.Equal(t, 1, calls)
+		assert.True(t, errors.Is(err, context.Canceled))
+		var msgErr *msg.MessageError
+		require.True(t, errors.As(err, &msgErr))
+		assert.Equal(t, 1, msgErr.Context[msg.KeyAttempts])
+		assert.Equal(t, "retry", msgErr.Context[msg.KeyOperation])
+	})
+
+	t.Run("does not call fn with an already cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		e := newTestExecutor(t, &recordingSleeper{})
+
+		err := e.Do(ctx, func(ctx context.Context) error {
+			t.Fatal("fn should not be called")
+			return nil
+		})
+
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+}
+
+func TestDo_ReturnsValue(t *testing.T) {
+	e := newTestExecutor(t, &recordingSleeper{})
+
+	calls := 0
+	value, err := retry.Do(context.Background(), e, func(ctx context.Context) (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, errors.New("flaky")
+		}
+		return 42, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, value)
+}
+
+func TestExecutor_Backoff(t *testing.T) {
+	t.Run("caps at max delay", func(t *testing.T) {
+		e := newTestExecutor(t, &recordingSleeper{})
+		assert.Equal(t, 100*time.Millisecond, e.Backoff(1))
+		assert.Equal(t, 800*time.Millisecond, e.Backoff(4))
+		assert.Equal(t, time.Second, e.Backoff(10))
+	})
+
+	t.Run("applies jitter within bounds", func(t *testing.T) {
+		low := newTestExecutor(t, &recordingSleeper{}, retry.WithJitter(0.5), retry.WithRandom(func() float64 { return 0 }))
+		high := newTestExecutor(t, &recordingSleeper{}, retry.WithJitter(0.5), retry.WithRandom(func() float64 { return 0.999 }))
+
+		assert.Equal(t, 50*time.Millisecond, low.Backoff(1))
+		assert.InDelta(t, float64(150*time.Millisecond), float64(high.Backoff(1)), float64(time.Millisecond))
+	})
+}
+
+func TestSleepContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, retry.SleepContext(ctx, time.Hour), context.Canceled)
+	assert.NoError(t, retry.SleepContext(context.Background(), time.Millisecond))
+}
@@ -43,7 +43,9 @@ func parseAuditTimeMultipleLayouts(timeStr string) (time.Time, error) {
 
 type CreatedAt time.Time
 
-func NewCreatedAt() CreatedAt { return CreatedAt(time.Now()) }
+func NewCreatedAt() CreatedAt { return NewCreatedAtFrom(DefaultClock()) }
+
+func NewCreatedAtFrom(c Clock) CreatedAt { return CreatedAt(c.Now()) }
 
 func (ca CreatedAt) Time() time.Time { return time.Time(ca) }
 
@@ -51,14 +53,14 @@ func (ca CreatedAt) MarshalJSON() ([]byte, error) { return json.Marshal(time.Tim
 func (ca *CreatedAt) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		return msg.NewValidationError(nil,
-			map[string]any{"input_json": "null", "target_type": "CreatedAt"},
+			msg.ConversionContext{TargetType: "CreatedAt", Source: msg.SourceJSON, Input: "null"}.ContextMap(),
 			"CreatedAt cannot be null (received JSON 'null').",
 		)
 	}
 	var t time.Time
 	if err := json.Unmarshal(data, &t); err != nil {
 		return msg.NewValidationError(err,
-			map[string]any{"input_json": string(data), "target_type": "CreatedAt"},
+			msg.ConversionContext{TargetType: "CreatedAt", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
 			"CreatedAt must be a valid JSON timestamp.",
 		)
 	}
@@ -69,7 +71,7 @@ func (ca CreatedAt) Value() (driver.Value, error) { return time.Time(ca), nil }
 func (ca *CreatedAt) Scan(src interface{}) error {
 	if src == nil {
 		return msg.NewValidationError(nil,
-			map[string]any{"target_type": "CreatedAt", "received_value": "nil_from_db"},
+			msg.ConversionContext{TargetType: "CreatedAt", Source: msg.SourceDB}.ContextMap(),
 			"Scanned nil value for non-nullable CreatedAt.",
 		)
 	}
@@ -85,7 +87,7 @@ func (ca *CreatedAt) Scan(src interface{}) error {
 		if err != nil {
 			message := fmt.Sprintf("Failed to convert []byte ('%s') to CreatedAt.", strVal)
 			return msg.NewValidationError(err,
-				map[string]any{"input_bytes": strVal, "target_type": "CreatedAt"},
+				msg.ConversionContext{TargetType: "CreatedAt", ReceivedType: "[]uint8", Source: msg.SourceDB, Input: strVal}.ContextMap(),
 				message,
 			)
 		}
@@ -96,7 +98,7 @@ func (ca *CreatedAt) Scan(src interface{}) error {
 		if err != nil {
 			message := fmt.Sprintf("Failed to convert string ('%s') to CreatedAt.", s)
 			return msg.NewValidationError(err,
-				map[string]any{"input_string": s, "target_type": "CreatedAt"},
+				msg.ConversionContext{TargetType: "CreatedAt", ReceivedType: "string", Source: msg.SourceDB, Input: s}.ContextMap(),
 				message,
 			)
 		}
@@ -105,7 +107,7 @@ func (ca *CreatedAt) Scan(src interface{}) error {
 	default:
 		message := fmt.Sprintf("Incompatible type (%T) for CreatedAt.", src)
 		return msg.NewValidationError(nil,
-			map[string]any{"received_type": fmt.Sprintf("%T", src), "target_type": "CreatedAt"},
+			msg.ConversionContext{TargetType: "CreatedAt", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
 			message,
 		)
 	}
@@ -117,21 +119,22 @@ func (ca CreatedAt) IsZero() bool {
 
 type UpdatedAt time.Time
 
-func NewUpdatedAt() UpdatedAt        { return UpdatedAt(time.Now()) }
-func (ua UpdatedAt) Time() time.Time { return time.Time(ua) }
+func NewUpdatedAt() UpdatedAt            { return NewUpdatedAtFrom(DefaultClock()) }
+func NewUpdatedAtFrom(c Clock) UpdatedAt { return UpdatedAt(c.Now()) }
+func (ua UpdatedAt) Time() time.Time     { return time.Time(ua) }
 
 func (ua UpdatedAt) MarshalJSON() ([]byte, error) { return json.Marshal(time.Time(ua)) }
 func (ua *UpdatedAt) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		return msg.NewValidationError(nil,
-			map[string]any{"input_json": "null", "target_type": "UpdatedAt"},
+			msg.ConversionContext{TargetType: "UpdatedAt", Source: msg.SourceJSON, Input: "null"}.ContextMap(),
 			"UpdatedAt cannot be null (received JSON 'null').",
 		)
 	}
 	var t time.Time
 	if err := json.Unmarshal(data, &t); err != nil {
 		return msg.NewValidationError(err,
-			map[string]any{"input_json": string(data), "target_type": "UpdatedAt"},
+			msg.ConversionContext{TargetType: "UpdatedAt", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
 			"UpdatedAt must be a valid JSON timestamp.",
 		)
 	}
@@ -142,7 +145,7 @@ func (ua UpdatedAt) Value() (driver.Value, error) { return time.Time(ua), nil }
 func (ua *UpdatedAt) Scan(src interface{}) error {
 	if src == nil {
 		return msg.NewValidationError(nil,
-			map[string]any{"target_type": "UpdatedAt", "received_value": "nil_from_db"},
+			msg.ConversionContext{TargetType: "UpdatedAt", Source: msg.SourceDB}.ContextMap(),
 			"Scanned nil value for non-nullable UpdatedAt.",
 		)
 	}
@@ -158,7 +161,7 @@ func (ua *UpdatedAt) Scan(src interface{}) error {
 		if err != nil {
 			message := fmt.Sprintf("Failed to convert []byte ('%s') to UpdatedAt.", strVal)
 			return msg.NewValidationError(err,
-				map[string]any{"input_bytes": strVal, "target_type": "UpdatedAt"},
+				msg.ConversionContext{TargetType: "UpdatedAt", ReceivedType: "[]uint8", Source: msg.SourceDB, Input: strVal}.ContextMap(),
 				message,
 			)
 		}
@@ -169,7 +172,7 @@ func (ua *UpdatedAt) Scan(src interface{}) error {
 		if err != nil {
 			message := fmt.Sprintf("Failed to convert string ('%s') to UpdatedAt.", s)
 			return msg.NewValidationError(err,
-				map[string]any{"input_string": s, "target_type": "UpdatedAt"},
+				msg.ConversionContext{TargetType: "UpdatedAt", ReceivedType: "string", Source: msg.SourceDB, Input: s}.ContextMap(),
 				message,
 			)
 		}
@@ -178,7 +181,7 @@ func (ua *UpdatedAt) Scan(src interface{}) error {
 	default:
 		message := fmt.Sprintf("Incompatible type (%T) for UpdatedAt.", src)
 		return msg.NewValidationError(nil,
-			map[string]any{"received_type": fmt.Sprintf("%T", src), "target_type": "UpdatedAt"},
+			msg.ConversionContext{TargetType: "UpdatedAt", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
 			message,
 		)
 	}
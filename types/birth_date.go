@@ -0,0 +1,231 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+const (
+	// DefaultMaxAge é a idade máxima plausível aceita por NewBirthDate.
+	DefaultMaxAge = 130
+	// DefaultAdultAge é a maioridade civil no Brasil (Código Civil, art. 5º).
+	DefaultAdultAge = 18
+)
+
+// Jurisdiction define a maioridade aplicada por IsAdult. AdultAge zero ou negativo,
+// como no valor zero, usa DefaultAdultAge.
+type Jurisdiction struct {
+	Code     string
+	AdultAge int
+}
+
+var (
+	JurisdictionBR = Jurisdiction{Code: "BR", AdultAge: DefaultAdultAge}
+	JurisdictionPT = Jurisdiction{Code: "PT", AdultAge: 18}
+	JurisdictionUS = Jurisdiction{Code: "US", AdultAge: 18}
+)
+
+func NewJurisdiction(code string, adultAge int) (Jurisdiction, error) {
+	if adultAge < 1 || adultAge > DefaultMaxAge {
+		message := fmt.Sprintf("Adult age for jurisdiction '%s' must be between 1 and %d, got %d.", code, DefaultMaxAge, adultAge)
+		return Jurisdiction{}, msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "jurisdiction", Input: adultAge, Constraint: "adult_age", Limit: DefaultMaxAge}.ContextMap(),
+			message,
+		)
+	}
+	return Jurisdiction{Code: code, AdultAge: adultAge}, nil
+}
+
+func (j Jurisdiction) adultAge() int {
+	if j.AdultAge <= 0 {
+		return DefaultAdultAge
+	}
+	return j.AdultAge
+}
+
+// BirthDate é uma data de nascimento. O valor zero representa data ausente e é
+// serializado como null/NULL.
+type BirthDate struct {
+	date Date
+}
+
+type birthDateConfig struct {
+	maxAge int
+	clock  Clock
+}
+
+type BirthDateOption func(*birthDateConfig)
+
+// WithMaxAge altera a idade máxima plausível; o padrão é DefaultMaxAge.
+func WithMaxAge(years int) BirthDateOption {
+	return func(c *birthDateConfig) {
+		c.maxAge = years
+	}
+}
+
+// WithBirthDateClock define o relógio que determina "hoje"; o padrão é DefaultClock.
+func WithBirthDateClock(clock Clock) BirthDateOption {
+	return func(c *birthDateConfig) {
+		c.clock = clock
+	}
+}
+
+func birthDateError(d Date, constraint, message string, limit any) *msg.MessageError {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "birth_date", Input: d.String(), Constraint: constraint, Limit: limit}.ContextMap(),
+		message,
+	)
+}
+
+// NewBirthDate rejeita datas futuras e idades acima da máxima plausível.
+func NewBirthDate(d Date, opts ...BirthDateOption) (BirthDate, error) {
+	cfg := birthDateConfig{maxAge: DefaultMaxAge}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.maxAge < 1 {
+		message := fmt.Sprintf("Maximum age must be positive, got %d.", cfg.maxAge)
+		return BirthDate{}, birthDateError(d, "max_age_config", message, 1)
+	}
+	if d.IsZero() {
+		return BirthDate{}, birthDateError(d, "required", "Birth date is required.", nil)
+	}
+	clock := cfg.clock
+	if clock == nil {
+		clock = DefaultClock()
+	}
+	today := DateOf(clock.Now())
+	if d.After(today) {
+		message := fmt.Sprintf("Birth date %s is in the future.", d)
+		return BirthDate{}, birthDateError(d, "future", message, today.String())
+	}
+	b := BirthDate{date: d}
+	if age := b.AgeOn(today); age > cfg.maxAge {
+		message := fmt.Sprintf("Birth date %s implies an age of %d, above the maximum of %d.", d, age, cfg.maxAge)
+		return BirthDate{}, birthDateError(d, "max_age", message, cfg.maxAge).WithContext(msg.KeyAge, age)
+	}
+	return b, nil
+}
+
+func MustNewBirthDate(d Date, opts ...BirthDateOption) BirthDate {
+	b, err := NewBirthDate(d, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// ParseBirthDate aceita os formatos de ParseDate e valida como NewBirthDate.
+func ParseBirthDate(value string, opts ...BirthDateOption) (BirthDate, error) {
+	d, err := ParseDate(value)
+	if err != nil {
+		return BirthDate{}, err
+	}
+	return NewBirthDate(d, opts...)
+}
+
+func (b BirthDate) Date() Date     { return b.date }
+func (b BirthDate) IsZero() bool   { return b.date.IsZero() }
+func (b BirthDate) String() string { return b.date.String() }
+
+func (b BirthDate) Format(locale Locale) (string, error) {
+	return b.date.Format(locale)
+}
+
+// AgeOn retorna a idade em anos completos na data ref; é negativa se ref for anterior ao
+// nascimento. Quem nasceu em 29/02 completa anos em 01/03 nos anos não bissextos, pois o
+// prazo vence no dia seguinte quando falta correspondência (Código Civil, art. 132, § 3º).
+// O valor zero (data ausente) não tem idade: AgeOn retorna 0 e as verificações de idade
+// mínima e maioridade retornam false.
+func (b BirthDate) AgeOn(ref Date) int {
+	if b.IsZero() {
+		return 0
+	}
+	age := ref.year - b.date.year
+	if ref.month < b.date.month || (ref.month == b.date.month && ref.day < b.date.day) {
+		age--
+	}
+	return age
+}
+
+// Age retorna a idade na data atual de DefaultClock.
+func (b BirthDate) Age() int {
+	return b.AgeOn(DateOf(DefaultClock().Now()))
+}
+
+func (b BirthDate) AgeAtLeastOn(years int, ref Date) bool {
+	return !b.IsZero() && b.AgeOn(ref) >= years
+}
+
+func (b BirthDate) AgeAtLeast(years int) bool {
+	return !b.IsZero() && b.Age() >= years
+}
+
+func (b BirthDate) IsAdultOn(j Jurisdiction, ref Date) bool {
+	return b.AgeAtLeastOn(j.adultAge(), ref)
+}
+
+func (b BirthDate) IsAdult(j Jurisdiction) bool {
+	return b.AgeAtLeast(j.adultAge())
+}
+
+// ValidateAgeAtLeast retorna um erro de validação com a idade calculada (msg.KeyAge)
+// quando a idade em ref é menor que years; ref zero usa a data atual de DefaultClock.
+func (b BirthDate) ValidateAgeAtLeast(years int, ref Date) error {
+	if b.IsZero() {
+		return birthDateError(b.date, "required", "Birth date is required.", nil)
+	}
+	if ref.IsZero() {
+		ref = DateOf(DefaultClock().Now())
+	}
+	if age := b.AgeOn(ref); age < years {
+		message := fmt.Sprintf("Age %d on %s is below the minimum of %d.", age, ref, years)
+		return birthDateError(b.date, "min_age", message, years).WithContext(msg.KeyAge, age)
+	}
+	return nil
+}
+
+// ValidateAdult valida a maioridade da jurisdição em ref, como ValidateAgeAtLeast.
+func (b BirthDate) ValidateAdult(j Jurisdiction, ref Date) error {
+	return b.ValidateAgeAtLeast(j.adultAge(), ref)
+}
+
+func (b BirthDate) MarshalJSON() ([]byte, error) {
+	return b.date.MarshalJSON()
+}
+
+// UnmarshalJSON valida a data com as regras padrão de NewBirthDate.
+func (b *BirthDate) UnmarshalJSON(data []byte) error {
+	var d Date
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	if d.IsZero() {
+		*b = BirthDate{}
+		return nil
+	}
+	parsed, err := NewBirthDate(d)
+	if err != nil {
+		return err
+	}
+	*b = parsed
+	return nil
+}
+
+func (b BirthDate) Value() (driver.Value, error) {
+	return b.date.Value()
+}
+
+// Scan não reaplica as regras de plausibilidade: registros gravados continuam legíveis
+// mesmo que a idade máxima mude.
+func (b *BirthDate) Scan(src interface{}) error {
+	var d Date
+	if err := d.Scan(src); err != nil {
+		return err
+	}
+	b.date = d
+	return nil
+}
@@ -0,0 +1,215 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+var birthDateNow = time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+
+func TestBirthDate_AgeOn(t *testing.T) {
+	testCases := []struct {
+		name     string
+		birth    types.Date
+		ref      types.Date
+		expected int
+	}{
+		{"birthday today", date(2008, time.October, 18), date(2026, time.October, 18), 18},
+		{"day before birthday", date(2008, time.October, 19), date(2026, time.October, 18), 17},
+		{"born today", date(2026, time.October, 18), date(2026, time.October, 18), 0},
+		{"before birth", date(2026, time.October, 18), date(2026, time.October, 17), -1},
+		{"leap day born, feb 28 of common year", date(2008, time.February, 29), date(2026, time.February, 28), 17},
+		{"leap day born, mar 1 of common year", date(2008, time.February, 29), date(2026, time.March, 1), 18},
+		{"leap day born, leap birthday", date(2008, time.February, 29), date(2028, time.February, 29), 20},
+		{"feb 28 born, leap day", date(2008, time.February, 28), date(2028, time.February, 29), 20},
+		{"dec 31 born, jan 1", date(2007, time.December, 31), date(2026, time.January, 1), 18},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := types.MustNewBirthDate(tc.birth, types.WithBirthDateClock(types.NewFixedClock(birthDateNow)))
+			assert.Equal(t, tc.expected, b.AgeOn(tc.ref))
+		})
+	}
+}
+
+func TestNewBirthDate(t *testing.T) {
+	clock := types.WithBirthDateClock(types.NewFixedClock(birthDateNow))
+
+	b, err := types.NewBirthDate(date(2026, time.October, 18), clock)
+	require.NoError(t, err, "today is a valid birth date")
+	assert.Equal(t, date(2026, time.October, 18), b.Date())
+
+	b, err = types.ParseBirthDate("29/02/2008", clock)
+	require.NoError(t, err)
+	assert.Equal(t, "2008-02-29", b.String())
+	formatted, err := b.Format(types.LocalePtBR)
+	require.NoError(t, err)
+	assert.Equal(t, "29/02/2008", formatted)
+
+	_, err = types.NewBirthDate(date(2026, time.October, 19), clock)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "birth_date", msgErr.Context[msg.KeyField])
+	assert.Equal(t, "future", msgErr.Context[msg.KeyConstraint])
+
+	_, err = types.NewBirthDate(date(1890, time.January, 1), clock)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "max_age", msgErr.Context[msg.KeyConstraint])
+	assert.Equal(t, 136, msgErr.Context[msg.KeyAge])
+	assert.Equal(t, types.DefaultMaxAge, msgErr.Context[msg.KeyLimit])
+
+	_, err = types.NewBirthDate(date(1900, time.January, 1), clock, types.WithMaxAge(120))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "max_age", msgErr.Context[msg.KeyConstraint])
+	assert.Equal(t, 126, msgErr.Context[msg.KeyAge])
+
+	_, err = types.NewBirthDate(date(2000, time.January, 1), types.WithMaxAge(0))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "max_age_config", msgErr.Context[msg.KeyConstraint])
+
+	_, err = types.NewBirthDate(types.Date{})
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "required", msgErr.Context[msg.KeyConstraint])
+
+	_, err = types.ParseBirthDate("31/02/2008")
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "date", msgErr.Context[msg.KeyField])
+
+	assert.Panics(t, func() { types.MustNewBirthDate(types.Date{}) })
+}
+
+func TestBirthDate_DefaultClock(t *testing.T) {
+	defer types.SetDefaultClock(types.NewFixedClock(birthDateNow))()
+
+	_, err := types.NewBirthDate(date(2026, time.October, 19))
+	requireMsgErrorCode(t, err, msg.CodeInvalid)
+
+	adult := types.MustNewBirthDate(date(2008, time.October, 18))
+	minor := types.MustNewBirthDate(date(2008, time.October, 19))
+
+	assert.Equal(t, 18, adult.Age())
+	assert.True(t, adult.IsAdult(types.JurisdictionBR))
+	assert.True(t, adult.AgeAtLeast(18))
+	assert.Equal(t, 17, minor.Age())
+	assert.False(t, minor.IsAdult(types.JurisdictionBR))
+	assert.True(t, minor.AgeAtLeast(16))
+
+	require.NoError(t, adult.ValidateAdult(types.JurisdictionBR, types.Date{}))
+	err = minor.ValidateAdult(types.JurisdictionBR, types.Date{})
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, 17, msgErr.Context[msg.KeyAge])
+}
+
+func TestBirthDate_Jurisdiction(t *testing.T) {
+	b := types.MustNewBirthDate(date(2008, time.February, 29), types.WithBirthDateClock(types.NewFixedClock(birthDateNow)))
+
+	uae, err := types.NewJurisdiction("AE", 21)
+	require.NoError(t, err)
+	ref := date(2026, time.March, 1)
+	assert.True(t, b.IsAdultOn(types.JurisdictionBR, ref))
+	assert.True(t, b.IsAdultOn(types.JurisdictionUS, ref))
+	assert.False(t, b.IsAdultOn(uae, ref))
+	assert.False(t, b.IsAdultOn(types.JurisdictionPT, date(2026, time.February, 28)))
+	assert.True(t, b.AgeAtLeastOn(18, ref))
+
+	err = b.ValidateAdult(uae, ref)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "birth_date", msgErr.Context[msg.KeyField])
+	assert.Equal(t, "min_age", msgErr.Context[msg.KeyConstraint])
+	assert.Equal(t, 21, msgErr.Context[msg.KeyLimit])
+	assert.Equal(t, 18, msgErr.Context[msg.KeyAge])
+	assert.Equal(t, "2008-02-29", msgErr.Context[msg.KeyInput])
+
+	err = b.ValidateAgeAtLeast(18, date(2026, time.February, 28))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, 17, msgErr.Context[msg.KeyAge])
+	require.NoError(t, b.ValidateAgeAtLeast(18, ref))
+
+	err = types.BirthDate{}.ValidateAgeAtLeast(18, ref)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "required", msgErr.Context[msg.KeyConstraint])
+
+	_, err = types.NewJurisdiction("XX", 0)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "jurisdiction", msgErr.Context[msg.KeyField])
+	assert.Equal(t, "adult_age", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestBirthDate_ZeroIsNeverAdult(t *testing.T) {
+	defer types.SetDefaultClock(types.NewFixedClock(birthDateNow))()
+
+	var payload struct {
+		B types.BirthDate `json:"b"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"b":null}`), &payload))
+
+	for _, b := range []types.BirthDate{payload.B, {}} {
+		assert.True(t, b.IsZero())
+		assert.Equal(t, 0, b.Age())
+		assert.Equal(t, 0, b.AgeOn(date(2026, time.October, 18)))
+		assert.False(t, b.IsAdult(types.JurisdictionBR))
+		assert.False(t, b.IsAdultOn(types.JurisdictionBR, date(2026, time.October, 18)))
+		assert.False(t, b.AgeAtLeast(0))
+		assert.False(t, b.AgeAtLeastOn(0, date(2026, time.October, 18)))
+		msgErr := requireMsgErrorCode(t, b.ValidateAdult(types.JurisdictionBR, types.Date{}), msg.CodeInvalid)
+		assert.Equal(t, "required", msgErr.Context[msg.KeyConstraint])
+	}
+}
+
+func TestBirthDate_ZeroJurisdictionUsesDefaultAdultAge(t *testing.T) {
+	defer types.SetDefaultClock(types.NewFixedClock(birthDateNow))()
+	child := types.MustNewBirthDate(date(2025, time.January, 1))
+	adult := types.MustNewBirthDate(date(2008, time.October, 18))
+
+	for _, j := range []types.Jurisdiction{{}, {Code: "XX", AdultAge: -1}} {
+		assert.False(t, child.IsAdult(j))
+		assert.False(t, child.IsAdultOn(j, date(2026, time.October, 18)))
+		msgErr := requireMsgErrorCode(t, child.ValidateAdult(j, types.Date{}), msg.CodeInvalid)
+		assert.Equal(t, types.DefaultAdultAge, msgErr.Context[msg.KeyLimit])
+		assert.Equal(t, 1, msgErr.Context[msg.KeyAge])
+
+		assert.True(t, adult.IsAdult(j))
+		require.NoError(t, adult.ValidateAdult(j, types.Date{}))
+	}
+}
+
+func TestBirthDate_JSONAndSQL(t *testing.T) {
+	defer types.SetDefaultClock(types.NewFixedClock(birthDateNow))()
+	b := types.MustNewBirthDate(date(2008, time.February, 29))
+
+	data, err := json.Marshal(b)
+	require.NoError(t, err)
+	assert.Equal(t, `"2008-02-29"`, string(data))
+
+	var decoded types.BirthDate
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, b, decoded)
+	require.NoError(t, json.Unmarshal([]byte(`null`), &decoded))
+	assert.True(t, decoded.IsZero())
+
+	err = json.Unmarshal([]byte(`"2027-01-01"`), &decoded)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "future", msgErr.Context[msg.KeyConstraint])
+	err = json.Unmarshal([]byte(`20080229`), &decoded)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "Date", msgErr.Context[msg.KeyTargetType])
+
+	value, err := b.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2008-02-29", value)
+
+	var scanned types.BirthDate
+	require.NoError(t, scanned.Scan(time.Date(1890, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, date(1890, time.January, 1), scanned.Date(), "stored rows are not revalidated")
+	require.NoError(t, scanned.Scan(nil))
+	assert.True(t, scanned.IsZero())
+	err = scanned.Scan(42)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+}
@@ -0,0 +1,311 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+type HolidayScope string
+
+const (
+	HolidayNational  HolidayScope = "national"
+	HolidayState     HolidayScope = "state"
+	HolidayMunicipal HolidayScope = "municipal"
+	HolidayCustom    HolidayScope = "custom"
+)
+
+func (s HolidayScope) IsValid() bool {
+	switch s {
+	case HolidayNational, HolidayState, HolidayMunicipal, HolidayCustom:
+		return true
+	default:
+		return false
+	}
+}
+
+type Holiday struct {
+	Date  Date         `json:"date"`
+	Name  string       `json:"name"`
+	Scope HolidayScope `json:"scope"`
+}
+
+type annualHoliday struct {
+	month time.Month
+	day   int
+	name  string
+	scope HolidayScope
+}
+
+// Calendar responde consultas de dias úteis: sábados, domingos e feriados
+// registrados não são dias úteis. É seguro para uso concorrente. O valor zero e
+// um *Calendar nil não têm feriados e consideram apenas os fins de semana.
+type Calendar struct {
+	mu       sync.RWMutex
+	national bool
+	annual   []annualHoliday
+	dated    map[Date]Holiday
+	cache    map[int]map[Date]Holiday
+}
+
+// NewCalendar cria um calendário apenas com fins de semana.
+func NewCalendar() *Calendar {
+	return &Calendar{dated: make(map[Date]Holiday), cache: make(map[int]map[Date]Holiday)}
+}
+
+// NewBrazilianCalendar cria um calendário com os feriados nacionais brasileiros,
+// incluindo Carnaval, Sexta-feira Santa e Corpus Christi.
+func NewBrazilianCalendar() *Calendar {
+	c := NewCalendar()
+	c.national = true
+	return c
+}
+
+// Easter retorna o domingo de Páscoa do calendário gregoriano (algoritmo de Meeus/Jones/Butcher).
+func Easter(year int) Date {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return Date{year: year, month: time.Month(month), day: day}
+}
+
+// BrazilianNationalHolidays lista os feriados nacionais do ano em ordem cronológica.
+func BrazilianNationalHolidays(year int) []Holiday {
+	national := func(d Date, name string) Holiday {
+		return Holiday{Date: d, Name: name, Scope: HolidayNational}
+	}
+	fixed := func(month time.Month, day int, name string) Holiday {
+		return national(Date{year: year, month: month, day: day}, name)
+	}
+	easter := Easter(year)
+	holidays := []Holiday{
+		fixed(time.January, 1, "Confraternização Universal"),
+		national(easter.AddDays(-48), "Carnaval"),
+		national(easter.AddDays(-47), "Carnaval"),
+		national(easter.AddDays(-2), "Sexta-feira Santa"),
+		fixed(time.April, 21, "Tiradentes"),
+		fixed(time.May, 1, "Dia do Trabalho"),
+		national(easter.AddDays(60), "Corpus Christi"),
+		fixed(time.September, 7, "Independência do Brasil"),
+		fixed(time.October, 12, "Nossa Senhora Aparecida"),
+		fixed(time.November, 2, "Finados"),
+		fixed(time.November, 15, "Proclamação da República"),
+		fixed(time.December, 25, "Natal"),
+	}
+	// Lei 14.759/2023 tornou o Dia da Consciência Negra feriado nacional a partir de 2024.
+	if year >= 2024 {
+		holidays = append(holidays, fixed(time.November, 20, "Dia Nacional de Zumbi e da Consciência Negra"))
+	}
+	sortHolidays(holidays)
+	return holidays
+}
+
+func sortHolidays(holidays []Holiday) {
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+}
+
+func holidayError(input any, constraint, message string) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "holiday", Input: input, Constraint: constraint}.ContextMap(),
+		message,
+	)
+}
+
+func validateHoliday(name string, scope HolidayScope) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", holidayError(name, "name", "Holiday name cannot be empty.")
+	}
+	if !scope.IsValid() {
+		return "", holidayError(string(scope), "scope", fmt.Sprintf("Holiday scope '%s' is not valid.", scope))
+	}
+	return name, nil
+}
+
+// AddHoliday registra um feriado em uma data específica, ex.: um feriado municipal decretado.
+func (c *Calendar) AddHoliday(h Holiday) error {
+	name, err := validateHoliday(h.Name, h.Scope)
+	if err != nil {
+		return err
+	}
+	if h.Date.IsZero() {
+		return holidayError(nil, "date", "Holiday date is required.")
+	}
+	h.Name = name
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.dated == nil {
+		c.dated = make(map[Date]Holiday)
+	}
+	c.dated[h.Date] = h
+	delete(c.cache, h.Date.year)
+	return nil
+}
+
+// AddAnnualHoliday registra um feriado que se repete todo ano, ex.: 9 de julho em SP.
+func (c *Calendar) AddAnnualHoliday(month time.Month, day int, name string, scope HolidayScope) error {
+	name, err := validateHoliday(name, scope)
+	if err != nil {
+		return err
+	}
+	// 2024 é bissexto, então 29/02 é aceito e só ocorre nos anos bissextos.
+	if _, err := NewDate(2024, month, day); err != nil {
+		return holidayError(fmt.Sprintf("%02d/%02d", day, int(month)), "date", fmt.Sprintf("Annual holiday %02d/%02d does not exist.", day, int(month)))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.annual = append(c.annual, annualHoliday{month: month, day: day, name: name, scope: scope})
+	c.cache = make(map[int]map[Date]Holiday)
+	return nil
+}
+
+func (c *Calendar) holidaysOf(year int) map[Date]Holiday {
+	if c == nil {
+		return nil
+	}
+	c.mu.RLock()
+	cached, ok := c.cache[year]
+	c.mu.RUnlock()
+	if ok {
+		return cached
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	holidays := make(map[Date]Holiday)
+	if c.national {
+		for _, h := range BrazilianNationalHolidays(year) {
+			holidays[h.Date] = h
+		}
+	}
+	for _, a := range c.annual {
+		if a.day > daysIn(year, a.month) {
+			continue
+		}
+		d := Date{year: year, month: a.month, day: a.day}
+		if _, exists := holidays[d]; !exists {
+			holidays[d] = Holiday{Date: d, Name: a.name, Scope: a.scope}
+		}
+	}
+	for d, h := range c.dated {
+		if d.year == year {
+			holidays[d] = h
+		}
+	}
+	if c.cache == nil {
+		c.cache = make(map[int]map[Date]Holiday)
+	}
+	c.cache[year] = holidays
+	return holidays
+}
+
+// Holidays lista todos os feriados do ano em ordem cronológica.
+func (c *Calendar) Holidays(year int) []Holiday {
+	byDate := c.holidaysOf(year)
+	holidays := make([]Holiday, 0, len(byDate))
+	for _, h := range byDate {
+		holidays = append(holidays, h)
+	}
+	sortHolidays(holidays)
+	return holidays
+}
+
+func (c *Calendar) HolidayOn(d Date) (Holiday, bool) {
+	h, ok := c.holidaysOf(d.year)[d]
+	return h, ok
+}
+
+func (c *Calendar) IsHoliday(d Date) bool {
+	_, ok := c.HolidayOn(d)
+	return ok
+}
+
+func (c *Calendar) IsBusinessDay(d Date) bool {
+	switch d.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	default:
+		return !c.IsHoliday(d)
+	}
+}
+
+// NextBusinessDay retorna d se já for dia útil; caso contrário, o próximo dia útil.
+func (c *Calendar) NextBusinessDay(d Date) Date {
+	for !c.IsBusinessDay(d) {
+		d = d.AddDays(1)
+	}
+	return d
+}
+
+// PreviousBusinessDay retorna d se já for dia útil; caso contrário, o dia útil anterior.
+func (c *Calendar) PreviousBusinessDay(d Date) Date {
+	for !c.IsBusinessDay(d) {
+		d = d.AddDays(-1)
+	}
+	return d
+}
+
+// AddBusinessDays avança (n > 0) ou recua (n < 0) n dias úteis a partir de d.
+func (c *Calendar) AddBusinessDays(d Date, n int) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		d = d.AddDays(step)
+		if c.IsBusinessDay(d) {
+			n--
+		}
+	}
+	return d
+}
+
+// BusinessDaysBetween conta os dias úteis no intervalo (from, to], convenção usada
+// na contagem de dias úteis da base 252. É negativo quando to é anterior a from.
+func (c *Calendar) BusinessDaysBetween(from, to Date) int {
+	sign := 1
+	if to.Before(from) {
+		from, to, sign = to, from, -1
+	}
+	count := 0
+	for d := from.AddDays(1); !d.After(to); d = d.AddDays(1) {
+		if c.IsBusinessDay(d) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+// NextBusinessDueDate resolve o vencimento deste dia e o prorroga para o próximo dia útil.
+// Um vencimento anterior a ref cuja prorrogação ainda não passou continua sendo o próximo.
+func (d Day) NextBusinessDueDate(ref time.Time, policy MonthEndPolicy, cal *Calendar) (Date, error) {
+	today := DateOf(ref)
+	previous, err := d.PreviousDueDate(ref, policy)
+	if err != nil {
+		return Date{}, err
+	}
+	if !previous.IsZero() {
+		if adjusted := cal.NextBusinessDay(previous); !adjusted.Before(today) {
+			return adjusted, nil
+		}
+	}
+	next, err := d.NextDueDate(ref, policy)
+	if err != nil {
+		return Date{}, err
+	}
+	return cal.NextBusinessDay(next), nil
+}
@@ -0,0 +1,181 @@
+package types_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func date(year int, month time.Month, day int) types.Date {
+	return types.MustNewDate(year, month, day)
+}
+
+func TestEaster(t *testing.T) {
+	testCases := map[int]types.Date{
+		2024: date(2024, time.March, 31),
+		2025: date(2025, time.April, 20),
+		2026: date(2026, time.April, 5),
+		2027: date(2027, time.March, 28),
+		2038: date(2038, time.April, 25),
+	}
+	for year, expected := range testCases {
+		assert.Equal(t, expected, types.Easter(year), "year %d", year)
+	}
+}
+
+func TestBrazilianNationalHolidays(t *testing.T) {
+	holidays := types.BrazilianNationalHolidays(2026)
+	require.Len(t, holidays, 13)
+
+	byName := make(map[string][]types.Date)
+	for i, h := range holidays {
+		assert.Equal(t, types.HolidayNational, h.Scope)
+		if i > 0 {
+			assert.True(t, holidays[i-1].Date.Before(h.Date), "holidays must be sorted")
+		}
+		byName[h.Name] = append(byName[h.Name], h.Date)
+	}
+	assert.Equal(t, []types.Date{date(2026, time.February, 16), date(2026, time.February, 17)}, byName["Carnaval"])
+	assert.Equal(t, []types.Date{date(2026, time.April, 3)}, byName["Sexta-feira Santa"])
+	assert.Equal(t, []types.Date{date(2026, time.June, 4)}, byName["Corpus Christi"])
+	assert.Equal(t, []types.Date{date(2026, time.November, 20)}, byName["Dia Nacional de Zumbi e da Consciência Negra"])
+
+	assert.Len(t, types.BrazilianNationalHolidays(2023), 12, "Consciência Negra is national only from 2024")
+}
+
+func TestCalendar_IsBusinessDay(t *testing.T) {
+	cal := types.NewBrazilianCalendar()
+
+	assert.True(t, cal.IsBusinessDay(date(2026, time.October, 16)))
+	assert.False(t, cal.IsBusinessDay(date(2026, time.October, 17)), "saturday")
+	assert.False(t, cal.IsBusinessDay(date(2026, time.October, 18)), "sunday")
+	assert.False(t, cal.IsBusinessDay(date(2026, time.October, 12)), "Nossa Senhora Aparecida")
+	assert.False(t, cal.IsBusinessDay(date(2028, time.February, 29)), "Carnaval in a leap year")
+
+	h, ok := cal.HolidayOn(date(2026, time.December, 25))
+	require.True(t, ok)
+	assert.Equal(t, "Natal", h.Name)
+
+	plain := types.NewCalendar()
+	assert.True(t, plain.IsBusinessDay(date(2026, time.October, 12)))
+	assert.Empty(t, plain.Holidays(2026))
+}
+
+func TestCalendar_NextAndPreviousBusinessDay(t *testing.T) {
+	cal := types.NewBrazilianCalendar()
+
+	assert.Equal(t, date(2026, time.October, 16), cal.NextBusinessDay(date(2026, time.October, 16)))
+	assert.Equal(t, date(2026, time.April, 6), cal.NextBusinessDay(date(2026, time.April, 3)), "Sexta-feira Santa and weekend")
+	assert.Equal(t, date(2026, time.February, 18), cal.NextBusinessDay(date(2026, time.February, 14)), "weekend and Carnaval")
+	assert.Equal(t, date(2026, time.November, 23), cal.NextBusinessDay(date(2026, time.November, 20)))
+	assert.Equal(t, date(2026, time.February, 13), cal.PreviousBusinessDay(date(2026, time.February, 17)))
+}
+
+func TestCalendar_AddBusinessDays(t *testing.T) {
+	cal := types.NewBrazilianCalendar()
+	start := date(2026, time.December, 24)
+
+	assert.Equal(t, date(2027, time.January, 4), cal.AddBusinessDays(start, 5))
+	assert.Equal(t, start, cal.AddBusinessDays(date(2027, time.January, 4), -5))
+	assert.Equal(t, start, cal.AddBusinessDays(start, 0))
+}
+
+func TestCalendar_BusinessDaysBetween(t *testing.T) {
+	cal := types.NewBrazilianCalendar()
+	from := date(2026, time.December, 24)
+	to := date(2027, time.January, 4)
+
+	assert.Equal(t, 5, cal.BusinessDaysBetween(from, to))
+	assert.Equal(t, -5, cal.BusinessDaysBetween(to, from))
+	assert.Equal(t, 0, cal.BusinessDaysBetween(from, from))
+	assert.Equal(t, 0, cal.BusinessDaysBetween(date(2026, time.October, 16), date(2026, time.October, 18)))
+}
+
+func TestCalendar_CustomHolidays(t *testing.T) {
+	cal := types.NewBrazilianCalendar()
+	require.NoError(t, cal.AddAnnualHoliday(time.July, 9, "Revolução Constitucionalista", types.HolidayState))
+	require.NoError(t, cal.AddHoliday(types.Holiday{Date: date(2027, time.January, 25), Name: " Aniversário de São Paulo ", Scope: types.HolidayMunicipal}))
+	require.NoError(t, cal.AddAnnualHoliday(time.February, 29, "Dia bissexto", types.HolidayCustom))
+
+	assert.False(t, cal.IsBusinessDay(date(2026, time.July, 9)))
+	assert.False(t, cal.IsBusinessDay(date(2030, time.July, 9)))
+
+	h, ok := cal.HolidayOn(date(2027, time.January, 25))
+	require.True(t, ok)
+	assert.Equal(t, "Aniversário de São Paulo", h.Name)
+	assert.Equal(t, types.HolidayMunicipal, h.Scope)
+	assert.True(t, cal.IsBusinessDay(date(2028, time.January, 25)), "dated holidays do not repeat")
+
+	assert.True(t, cal.IsHoliday(date(2032, time.February, 29)))
+	assert.Len(t, cal.Holidays(2026), 14)
+}
+
+func TestCalendar_InvalidHolidays(t *testing.T) {
+	cal := types.NewCalendar()
+	testCases := []struct {
+		name       string
+		err        error
+		constraint string
+	}{
+		{"empty name", cal.AddAnnualHoliday(time.July, 9, " ", types.HolidayState), "name"},
+		{"invalid scope", cal.AddAnnualHoliday(time.July, 9, "Feriado", types.HolidayScope("federal")), "scope"},
+		{"invalid annual date", cal.AddAnnualHoliday(time.April, 31, "Feriado", types.HolidayCustom), "date"},
+		{"missing date", cal.AddHoliday(types.Holiday{Name: "Feriado", Scope: types.HolidayCustom}), "date"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msgErr := requireMsgErrorCode(t, tc.err, msg.CodeInvalid)
+			assert.Equal(t, "holiday", msgErr.Context[msg.KeyField])
+			assert.Equal(t, tc.constraint, msgErr.Context[msg.KeyConstraint])
+		})
+	}
+}
+
+func TestCalendar_ZeroAndNil(t *testing.T) {
+	var zero types.Calendar
+	assert.True(t, zero.IsBusinessDay(date(2026, time.December, 25)), "zero calendar has no holidays")
+	require.NoError(t, zero.AddHoliday(types.Holiday{Date: date(2026, time.December, 24), Name: "Véspera de Natal", Scope: types.HolidayCustom}))
+	assert.True(t, zero.IsHoliday(date(2026, time.December, 24)))
+
+	var nilCal *types.Calendar
+	assert.False(t, nilCal.IsBusinessDay(date(2026, time.October, 17)), "weekends still apply")
+	assert.True(t, nilCal.IsBusinessDay(date(2026, time.December, 25)))
+	assert.Empty(t, nilCal.Holidays(2026))
+
+	due, err := types.Day(3).NextBusinessDueDate(time.Date(2026, time.April, 1, 10, 0, 0, 0, time.UTC), types.MonthEndClamp, nilCal)
+	require.NoError(t, err)
+	assert.Equal(t, date(2026, time.April, 3), due, "Good Friday is a business day without holidays")
+}
+
+func TestCalendar_ConcurrentAccess(t *testing.T) {
+	cal := types.NewBrazilianCalendar()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = cal.IsBusinessDay(date(2026+i, time.January, 2))
+			_ = cal.AddHoliday(types.Holiday{Date: date(2026+i, time.March, 3), Name: "Feriado", Scope: types.HolidayCustom})
+		}(i)
+	}
+	wg.Wait()
+	assert.True(t, cal.IsHoliday(date(2030, time.March, 3)))
+}
+
+func TestDay_NextBusinessDueDate(t *testing.T) {
+	cal := types.NewBrazilianCalendar()
+
+	due, err := types.Day(3).NextBusinessDueDate(time.Date(2026, time.April, 4, 10, 0, 0, 0, time.UTC), types.MonthEndClamp, cal)
+	require.NoError(t, err)
+	assert.Equal(t, date(2026, time.April, 6), due, "due on Good Friday is payable on Monday")
+
+	due, err = types.Day(3).NextBusinessDueDate(time.Date(2026, time.April, 7, 10, 0, 0, 0, time.UTC), types.MonthEndClamp, cal)
+	require.NoError(t, err)
+	assert.Equal(t, date(2026, time.May, 4), due, "May 3 is a Sunday")
+}
@@ -0,0 +1,205 @@
+package types
+
+import (
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+func init() {
+	for _, entry := range catalogEntries() {
+		msg.MustRegister(entry)
+	}
+}
+
+func validationEntry(field, constraint, message, description string, input any, limit any) msg.CatalogEntry {
+	return msg.CatalogEntry{
+		ID:          msg.CatalogID(field, constraint),
+		Code:        msg.CodeInvalid,
+		Message:     message,
+		Description: description,
+		Example: msg.ErrorResponse{
+			Context: msg.ValidationContext{Field: field, Input: input, Constraint: constraint, Limit: limit}.ContextMap(),
+		},
+	}
+}
+
+func domainEntry(field, constraint, message, description string, input any, limit any) msg.CatalogEntry {
+	entry := validationEntry(field, constraint, message, description, input, limit)
+	entry.Code = msg.CodeDomainViolation
+	return entry
+}
+
+func conversionEntry(targetType, source, message, description string, input any) msg.CatalogEntry {
+	return msg.CatalogEntry{
+		ID:          msg.CatalogID(targetType, source),
+		Code:        msg.CodeInvalid,
+		Message:     message,
+		Description: description,
+		Example: msg.ErrorResponse{
+			Context: msg.ConversionContext{TargetType: targetType, Source: source, Input: input}.ContextMap(),
+		},
+	}
+}
+
+func catalogEntries() []msg.CatalogEntry {
+	return []msg.CatalogEntry{
+		validationEntry("email", "required", "Email address cannot be empty.", "The email address is empty or contains only spaces.", "", nil),
+		validationEntry("email", "max_length", "Email address exceeds maximum length.", "The normalized email address is longer than MaxEmailLength.", "very-long@example.com", MaxEmailLength),
+		validationEntry("email", "format", "Email address has an invalid format.", "The email address does not match the accepted format.", "invalid", nil),
+		conversionEntry("Email", msg.SourceJSON, "Email must be a valid JSON string.", "The JSON value for an Email is not a string.", "123"),
+		conversionEntry("Email", msg.SourceDB, "Failed to scan database value to Email.", "The database value is null, of an unsupported type or not a valid email.", nil),
+
+		validationEntry("phone", "required", "Phone number cannot be empty.", "The phone number is empty or contains only spaces.", "", nil),
+		validationEntry("phone", "max_length", "Raw phone input exceeds maximum length.", "The raw phone input is longer than MaxRawPhoneInputLength.", "+55 (11) 99999-9999 ramal 1234567", MaxRawPhoneInputLength),
+		validationEntry("phone", "format", "Invalid phone number format.", "An 11-digit number starting with the default country code is ambiguous.", "55999999999", nil),
+		validationEntry("phone", "length", "Normalized phone number has the wrong number of digits.", "After normalization the phone number must have NormalizedPhoneLength digits.", "1234", NormalizedPhoneLength),
+		validationEntry("phone", "country_code", "Normalized phone number must start with the default country code.", "A 13-digit phone number must start with DefaultCountryCode.", "1111999999999", DefaultCountryCode),
+		conversionEntry("Phone", msg.SourceJSON, "Phone must be a valid JSON string.", "The JSON value for a Phone is not a string.", "123"),
+		conversionEntry("Phone", msg.SourceDB, "Failed to read or write Phone in the database.", "The database value is null, empty, of an unsupported type or not a valid phone.", nil),
+
+		validationEntry("uuid", "format", "Invalid UUID string format.", "The string is not a valid UUID.", "not-a-uuid", nil),
+		conversionEntry("UUID", msg.SourceText, "Invalid text representation for UUID.", "The text value is not a valid UUID.", "not-a-uuid"),
+		conversionEntry("UUID", msg.SourceDB, "Failed to scan database value into UUID.", "The database value cannot be converted to a UUID.", nil),
+		conversionEntry("NullableUUID", msg.SourceJSON, "NullableUUID must be a valid JSON UUID string or 'null'.", "The JSON value is neither a UUID string nor null.", "123"),
+
+		conversionEntry("CreatedAt", msg.SourceJSON, "CreatedAt must be a valid JSON timestamp.", "The JSON value is null or not an RFC 3339 timestamp.", "null"),
+		conversionEntry("CreatedAt", msg.SourceDB, "Failed to scan database value to CreatedAt.", "The database value is null or cannot be parsed as a timestamp.", nil),
+		conversionEntry("UpdatedAt", msg.SourceJSON, "UpdatedAt must be a valid JSON timestamp.", "The JSON value is null or not an RFC 3339 timestamp.", "null"),
+		conversionEntry("UpdatedAt", msg.SourceDB, "Failed to scan database value to UpdatedAt.", "The database value is null or cannot be parsed as a timestamp.", nil),
+		conversionEntry("NullableTime", msg.SourceJSON, "NullableTime must be a valid JSON timestamp or 'null'.", "The JSON value is neither a timestamp nor null.", "123"),
+
+		validationEntry("version", "int_range", "Version from database is out of range.", "The database value does not fit in an int.", nil, nil),
+		conversionEntry("Version", msg.SourceJSON, "Version must be a JSON number.", "The JSON value is null or not a number.", "null"),
+		conversionEntry("Version", msg.SourceDB, "Failed to scan database value to Version.", "The database value is null or cannot be converted to an integer.", nil),
+
+		validationEntry("currency", "invalid", "Currency is not valid.", "The code is not an ISO 4217 currency or is not among the currencies allowed by SetAllowedCurrencies.", "ABC", nil),
+		validationEntry("currency", "iso4217", "Currency is not an ISO 4217 currency.", "SetAllowedCurrencies only accepts codes from the ISO 4217 table.", "ABC", nil),
+		validationEntry("currency", "allowed_list", "At least one currency must be allowed.", "SetAllowedCurrencies was called without currencies; use ResetAllowedCurrencies to accept all of them.", nil, nil),
+		conversionEntry("Currency", msg.SourceDB, "Failed to scan database value into Currency.", "The database value is not a string or []byte.", 42),
+		validationEntry("money", "currency", "Money currency is not valid.", "The currency of a Money value is not allowed.", "ABC", nil),
+		domainEntry("money", "same_currency", "Cannot operate on Money with different currencies.", "Arithmetic and comparisons require both values to share the same currency.", "USD", "BRL"),
+		domainEntry("money", "overflow", "Money operation overflows int64.", "The result of the operation does not fit in int64 minor units.", nil, nil),
+		validationEntry("money", "locale", "Locale is not supported for Money formatting.", "Money formatting and parsing support pt-BR and en-US.", "fr-FR", []Locale{LocalePtBR, LocaleEnUS}),
+		validationEntry("money", "required", "Money value has no amount.", "The text to parse is empty or contains only a currency symbol.", "R$", nil),
+		validationEntry("money", "format", "Money value has an invalid format for the locale.", "The text does not follow the locale's digit grouping and decimal separator.", "1.234.56", nil),
+		validationEntry("money", "decimal_places", "Money value has too many decimal places.", "The text has more decimal places than the currency's minor units.", "1,234", nil),
+		validationEntry("money", "range", "Money value is out of range.", "The parsed amount does not fit in int64 minor units.", "92,233,720,368,547,758.08", nil),
+		conversionEntry("Money", msg.SourceJSON, "Money must be a JSON object with integer amount and currency.", "The JSON value is not an object with an integer amount and a currency code.", `{"amount":"1.5"}`),
+		conversionEntry("Money", msg.SourceDB, "Failed to scan database value to Money.", "The database value is null or does not match the Money layout named in the error context.", nil),
+
+		validationEntry("allocation", "required", "Allocation requires at least one share.", "Allocate, Split and AllocateShares need at least one part.", 0, nil),
+		validationEntry("allocation", "min_parts", "Money can only be split into at least 1 part.", "Split requires a positive number of parts.", 0, 1),
+		validationEntry("allocation", "non_negative", "Allocation weight or minimum cannot be negative.", "Share weights and minimums must be zero or positive.", -1, 0),
+		validationEntry("allocation", "positive_total", "Allocation weights must sum to a positive value.", "At least one share must have a positive weight.", "0", nil),
+		validationEntry("allocation", "minimum_total", "Allocation minimums exceed the amount to allocate.", "The sum of share minimums is greater than the absolute amount being allocated.", "1100", "1000"),
+		validationEntry("installment", "positive_principal", "Installment principal must be positive.", "The amount to be split into installments must be greater than zero.", 0, nil),
+		validationEntry("installment", "count_range", "Installment count is out of range.", "The number of installments must be between 1 and MaxInstallments.", 0, MaxInstallments),
+		validationEntry("installment", "system", "Amortization system is not valid.", "Supported systems are none, price and sac.", "german", []AmortizationSystem{AmortizationNone, AmortizationPrice, AmortizationSAC}),
+		validationEntry("installment", "rate", "Monthly rate must be a non-negative decimal fraction.", "Interest-bearing systems require an exact monthly rate such as 0.0199.", "abc", nil),
+		validationEntry("installment", "billing_day", ErrInvalidDay.Error(), "The billing day used to derive due dates must be between 1 and 31.", 0, 31),
+		validationEntry("installment", "start_date", "Installment start date is required.", "The purchase date used to derive the first due date is missing.", nil, nil),
+		validationEntry("exchange_rate", "positive", "Exchange rate must be a positive number.", "Exchange rates are exact decimal or fractional texts greater than zero.", "-1", nil),
+		{
+			ID:          msg.CatalogID("exchange_rate", "not_found"),
+			Code:        msg.CodeNotFound,
+			Message:     "No exchange rate available for the currency pair.",
+			Description: "The rate provider has no rate for the pair or its inverse.",
+			Example: msg.ErrorResponse{
+				Context: msg.ValidationContext{Field: "exchange_rate", Input: "USD/BRL", Constraint: "not_found"}.ContextMap(),
+			},
+		},
+		domainEntry("exchange_rate", "max_age", "Exchange rate is stale.", "The rate returned by the provider is older than the converter's maximum age.", "USD/BRL", "24h0m0s"),
+		conversionEntry("ExchangeRate", msg.SourceCSV, "Exchange rate CSV is invalid.", "A line of the rates CSV could not be read or has invalid currencies, rate or timestamp.", "USD,BRL,abc,2026-01-01T00:00:00Z"),
+		validationEntry("rate_provider", "required", "Converter requires a rate provider.", "A Converter was created without a RateProvider.", nil, nil),
+		validationEntry("rounding", "enum", "Rounding mode is not valid.", "Rounding modes are half_even, half_up, half_down, down, up, ceiling and floor.", "nearest", nil),
+
+		validationEntry("penalty", "non_negative_amount", "Penalty amount cannot be negative.", "The overdue amount must be zero or positive.", -100, 0),
+		validationEntry("penalty", "payment_date", "Penalty payment date is required.", "The payment date used to count overdue days is missing.", nil, nil),
+		validationEntry("penalty", "due_date", "Penalty requires a due date or a valid billing day.", "Neither a due date nor a billing day between 1 and 31 was informed.", nil, nil),
+		validationEntry("penalty", "grace_days", "Penalty grace days cannot be negative.", "The grace period must be zero or a positive number of days.", -1, 0),
+		validationEntry("penalty", "interest_period", "Interest period is not valid.", "Supported interest periods are daily and monthly.", "weekly", []InterestPeriod{InterestDaily, InterestMonthly}),
+		validationEntry("penalty", "fine_rate", "Penalty fine rate must be a non-negative decimal fraction.", "The fine (multa) must be an exact fraction such as 0.02.", "2%", nil),
+		validationEntry("penalty", "interest_rate", "Penalty interest rate must be a non-negative decimal fraction.", "The late interest (juros de mora) must be an exact fraction such as 0.01.", "1%", nil),
+		validationEntry("penalty", "correction_rate", "Penalty correction rate must be a non-negative decimal fraction.", "The accumulated monetary correction must be an exact fraction such as 0.0123.", "abc", nil),
+
+		validationEntry("decimal", "format", "Invalid decimal format.", "The text is not a plain or exponent decimal number such as 1234.56 or 1.5e3.", "1,5", nil),
+		validationEntry("decimal", "scale", "Decimal scale is out of range.", "The number of digits after the decimal point must be between 0 and MaxDecimalScale.", -1, MaxDecimalScale),
+		validationEntry("decimal", "exponent", "Decimal exponent is out of range.", "The exponent of a number in scientific notation must be within ±MaxDecimalExponent.", "1e16000", MaxDecimalExponent),
+		domainEntry("decimal", "division_by_zero", "Cannot divide decimal by zero.", "The divisor of a Decimal division is zero.", "10", nil),
+		conversionEntry("Decimal", msg.SourceJSON, "Decimal must be a JSON string or number.", "The JSON value is null or neither a string nor a number.", "null"),
+		conversionEntry("Decimal", msg.SourceDB, "Failed to scan database value to Decimal.", "The database value is null, of an unsupported type or not a valid decimal.", nil),
+
+		validationEntry("rate", "format", "Rate is not a valid decimal number.", "The rate value must be an exact decimal such as 0.0199 or, as a percentage, 1.99.", "1,99%", nil),
+		validationEntry("rate", "period", "Rate period is not valid.", "Supported periods are daily, monthly, yearly and business_day_252.", "weekly", []RatePeriod{RatePeriodDaily, RatePeriodMonthly, RatePeriodYearly, RatePeriodBusinessDay}),
+		validationEntry("rate", "range", "Rate must be greater than -100%.", "A rate of -100% or lower cannot be compounded.", "-1", "-1"),
+		validationEntry("rate", "index", "Indexed rate requires an index name.", "The index of an indexed rate is empty or the spread is already indexed.", "", nil),
+		domainEntry("rate", "indexed", "Rate must be resolved against its index first.", "Indexed rates such as CDI + 2% a.a. cannot be applied before the index value is known.", "CDI", nil),
+		validationEntry("rate", "locale", "Locale is not supported for rate formatting.", "Rate formatting supports pt-BR and en-US.", "fr-FR", []Locale{LocalePtBR, LocaleEnUS}),
+		validationEntry("rate", "periods", "Number of rate periods cannot be negative.", "Interest must be applied over zero or more periods.", -1, 0),
+		validationEntry("rate", "min", "Rate is below the allowed minimum.", "The rate is lower than the minimum bound after converting it to the same period.", "-0.01", "0 monthly"),
+		validationEntry("rate", "max", "Rate is above the allowed maximum.", "The rate is higher than the maximum bound after converting it to the same period.", "0.2", "0.12 yearly"),
+		conversionEntry("Rate", msg.SourceJSON, "Rate must be a JSON object with value and period.", "The JSON value is not an object with a decimal value and a period.", "1.99"),
+		conversionEntry("Rate", msg.SourceDB, "Failed to scan database value to Rate.", "The database value is of an unsupported type or not a valid rate JSON.", nil),
+
+		validationEntry("date", "format", "Invalid date format.", "Dates must be written as YYYY-MM-DD or DD/MM/YYYY.", "2026/10/16", nil),
+		validationEntry("date", "range", "Date does not exist.", "The year, month and day do not form a valid calendar date between years 1 and 9999.", "2026-02-30", nil),
+		validationEntry("date", "locale", "Locale is not supported for date formatting.", "Date formatting supports pt-BR and en-US.", "fr-FR", []Locale{LocalePtBR, LocaleEnUS}),
+		validationEntry("month_end_policy", "enum", "Month-end policy is not valid.", "The policy applied when a day does not exist in the target month is unknown.", "last", nil),
+		conversionEntry("Date", msg.SourceJSON, "Date must be a JSON string in YYYY-MM-DD format or 'null'.", "The JSON value is neither a date string nor null.", "20261016"),
+		conversionEntry("Date", msg.SourceDB, "Failed to scan database value to Date.", "The database value is of an unsupported type or not an ISO date.", nil),
+
+		validationEntry("year_month", "format", "Invalid year-month format.", "Year-months must be written as YYYY-MM or MM/YYYY.", "2026/10", nil),
+		validationEntry("year_month", "range", "Year-month does not exist.", "The month must be between 1 and 12 and the year between 1 and 9999.", "2026-13", nil),
+		validationEntry("year_month", "locale", "Locale is not supported for year-month formatting.", "Year-month formatting supports pt-BR and en-US.", "fr-FR", []Locale{LocalePtBR, LocaleEnUS}),
+		conversionEntry("YearMonth", msg.SourceJSON, "YearMonth must be a JSON string in YYYY-MM format or 'null'.", "The JSON value is neither a year-month string nor null.", "202610"),
+		conversionEntry("YearMonth", msg.SourceDB, "Failed to scan database value to YearMonth.", "The database value is of an unsupported type or not a valid year-month.", nil),
+
+		validationEntry("holiday", "name", "Holiday name cannot be empty.", "Registered holidays must have a name.", "", nil),
+		validationEntry("holiday", "scope", "Holiday scope is not valid.", "Supported scopes are national, state, municipal and custom.", "federal", []HolidayScope{HolidayNational, HolidayState, HolidayMunicipal, HolidayCustom}),
+		validationEntry("holiday", "date", "Holiday date is missing or does not exist.", "Dated holidays require a date and annual holidays a valid day and month.", "31/04", nil),
+
+		validationEntry("recurrence", "frequency", "Recurrence frequency is not valid.", "Supported frequencies are daily, weekly, monthly and yearly.", "biweekly", []RecurrenceFrequency{RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly, RecurrenceYearly}),
+		validationEntry("recurrence", "interval", "Recurrence interval cannot be negative.", "The interval is the number of periods between occurrences; zero means every period.", -1, 0),
+		validationEntry("recurrence", "count", "Number of occurrences cannot be negative.", "Occurrence counts must be zero (unlimited) or positive.", -1, 0),
+		validationEntry("recurrence", "start", "Recurrence start date is required.", "Every recurrence rule needs the date its schedule starts from.", nil, nil),
+		validationEntry("recurrence", "until", "Recurrence end date is before the start date.", "The until date must be on or after the start date.", "2026-01-01", nil),
+		validationEntry("recurrence", "month", "Recurrence month must be between 1 and 12.", "Yearly rules take the month of the year they occur in.", 13, nil),
+		validationEntry("recurrence", "combination", "Recurrence rule combines incompatible options.", "Day and month apply to monthly and yearly rules, last business day only to monthly rules, and a yearly rule must be able to occur.", "weekly", nil),
+		conversionEntry("RecurrenceRule", msg.SourceJSON, "RecurrenceRule must be a valid JSON object.", "The JSON value is not an object describing a recurrence rule.", "\"monthly\""),
+		conversionEntry("RecurrenceRule", msg.SourceDB, "Failed to scan database value to RecurrenceRule.", "The database value is null, of an unsupported type or not a valid recurrence rule JSON.", nil),
+
+		validationEntry("time_range", "format", "Invalid time range format.", "Time ranges are written as the PostgreSQL tstzrange text '[start,end)' with both bounds.", "(,2026-02-01)", nil),
+		validationEntry("time_range", "required", "Time range requires both start and end.", "Unbounded time ranges are not supported.", nil, nil),
+		validationEntry("time_range", "order", "Time range start must be before end.", "Ranges are half-open [start, end) and cannot be empty.", "2026-02-01T00:00:00Z", nil),
+		conversionEntry("TimeRange", msg.SourceJSON, "TimeRange must be a JSON object with start and end, a range string or 'null'.", "The JSON value is neither a range object, a tstzrange string nor null.", "42"),
+		conversionEntry("TimeRange", msg.SourceDB, "Failed to scan database value to TimeRange.", "The database value is of an unsupported type or not a valid tstzrange.", nil),
+		validationEntry("date_range", "format", "Invalid date range format.", "Date ranges are written as the PostgreSQL daterange text '[start,end)' with both bounds.", "[2026-01-01,)", nil),
+		validationEntry("date_range", "required", "Date range requires both start and end.", "Unbounded date ranges are not supported.", nil, nil),
+		validationEntry("date_range", "order", "Date range start must be before end.", "Ranges are half-open [start, end) and cannot be empty.", "2026-02-01", nil),
+		conversionEntry("DateRange", msg.SourceJSON, "DateRange must be a JSON object with start and end, a range string or 'null'.", "The JSON value is neither a range object, a daterange string nor null.", "42"),
+		conversionEntry("DateRange", msg.SourceDB, "Failed to scan database value to DateRange.", "The database value is of an unsupported type or not a valid daterange.", nil),
+
+		validationEntry("time_of_day", "format", "Invalid time of day format.", "Times of day must be written as HH:MM or HH:MM:SS.", "8h00", nil),
+		validationEntry("time_of_day", "range", "Time of day does not exist.", "Hours must be between 0 and 23, minutes and seconds between 0 and 59.", "24:00:00", nil),
+		conversionEntry("TimeOfDay", msg.SourceJSON, "TimeOfDay must be a JSON string in HH:MM format.", "The JSON value is not a time of day string.", "800"),
+		conversionEntry("TimeOfDay", msg.SourceDB, "Failed to scan database value to TimeOfDay.", "The database value is null, of an unsupported type or not a valid TIME.", nil),
+		validationEntry("weekly_schedule", "weekday", "Weekday is not valid.", "Weekdays go from Sunday (0) to Saturday (6) and are named in English in JSON.", "segunda", nil),
+		validationEntry("weekly_schedule", "overlap", "Schedule intervals overlap.", "Intervals opening on the same day cannot overlap.", "08:00-18:00", nil),
+		validationEntry("weekly_schedule", "exception_date", "Schedule exception date is required.", "Exceptions replace the intervals of a specific date.", nil, nil),
+		validationEntry("weekly_schedule", "timezone", "Time zone is not valid.", "Schedules are evaluated in an IANA time zone such as America/Sao_Paulo.", "BRT", nil),
+		conversionEntry("WeeklySchedule", msg.SourceJSON, "WeeklySchedule must be a JSON object with timezone and days.", "The JSON value is not an object describing a weekly schedule.", "[]"),
+		conversionEntry("WeeklySchedule", msg.SourceDB, "Failed to scan database value to WeeklySchedule.", "The database value is null, of an unsupported type or not a valid weekly schedule JSON.", nil),
+
+		validationEntry("prorata", "non_negative_amount", "Pro-rata amounts cannot be negative.", "Current and new plan amounts are full-cycle prices in minor units.", -100, 0),
+		validationEntry("prorata", "change_date", "Pro-rata change date is required.", "The change date selects the billing cycle and splits it into used and remaining days.", nil, nil),
+		validationEntry("prorata", "convention", "Day count convention is not valid.", "Supported conventions are actual days and 30/360.", "30/365", []DayCountConvention{DayCountActual, DayCount30360}),
+
+		validationEntry("birth_date", "required", "Birth date is required.", "A birth date must be informed before ages can be computed.", nil, nil),
+		validationEntry("birth_date", "future", "Birth date is in the future.", "Birth dates cannot be after the current date of the configured clock.", "2099-01-01", "today"),
+		validationEntry("birth_date", "max_age", "Birth date implies an implausible age.", "The computed age, available in the 'age' context key, exceeds the configured maximum.", "1850-01-01", DefaultMaxAge),
+		validationEntry("birth_date", "max_age_config", "Maximum age must be positive.", "The maximum plausible age configured with WithMaxAge must be at least one year.", nil, 1),
+		validationEntry("birth_date", "min_age", "Age is below the required minimum.", "The computed age, available in the 'age' context key, is lower than the minimum or the jurisdiction's adult age.", "2010-05-20", DefaultAdultAge),
+		validationEntry("jurisdiction", "adult_age", "Adult age is out of range.", "Jurisdictions must define an adult age between 1 and the maximum plausible age.", 0, DefaultMaxAge),
+
+		validationEntry("day", "range", ErrInvalidDay.Error(), "Day values used to resolve due dates must be between 1 and 31.", 32, 31),
+	}
+}
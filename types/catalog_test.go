@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestCatalog_TypesEntriesRegistered(t *testing.T) {
+	ids := []string{
+		"email.required", "email.max_length", "email.format", "email.json", "email.db",
+		"phone.required", "phone.max_length", "phone.format", "phone.length", "phone.country_code", "phone.json", "phone.db",
+		"uuid.format", "uuid.text", "uuid.db", "nullableuuid.json",
+		"createdat.json", "createdat.db", "updatedat.json", "updatedat.db", "nullabletime.json",
+		"version.int_range", "version.json", "version.db",
+		"currency.invalid", "currency.iso4217", "currency.allowed_list", "currency.db",
+		"money.currency", "money.same_currency", "money.overflow", "money.json", "money.db",
+		"money.locale", "money.required", "money.format", "money.decimal_places", "money.range",
+		"exchange_rate.positive", "exchange_rate.not_found", "exchange_rate.max_age", "exchangerate.csv", "rate_provider.required", "rounding.enum",
+		"allocation.required", "allocation.min_parts", "allocation.non_negative", "allocation.positive_total", "allocation.minimum_total",
+		"installment.positive_principal", "installment.count_range", "installment.system", "installment.rate", "installment.billing_day", "installment.start_date",
+		"penalty.non_negative_amount", "penalty.payment_date", "penalty.due_date", "penalty.grace_days", "penalty.interest_period",
+		"penalty.fine_rate", "penalty.interest_rate", "penalty.correction_rate",
+		"decimal.format", "decimal.scale", "decimal.exponent", "decimal.division_by_zero", "decimal.json", "decimal.db",
+		"rate.format", "rate.period", "rate.range", "rate.index", "rate.indexed", "rate.locale", "rate.periods", "rate.min", "rate.max", "rate.json", "rate.db",
+		"date.format", "date.range", "date.locale", "month_end_policy.enum", "date.json", "date.db",
+		"year_month.format", "year_month.range", "year_month.locale", "yearmonth.json", "yearmonth.db",
+		"holiday.name", "holiday.scope", "holiday.date",
+		"recurrence.frequency", "recurrence.interval", "recurrence.count", "recurrence.start", "recurrence.until",
+		"recurrence.month", "recurrence.combination", "recurrencerule.json", "recurrencerule.db",
+		"time_range.format", "time_range.required", "time_range.order", "timerange.json", "timerange.db",
+		"date_range.format", "date_range.required", "date_range.order", "daterange.json", "daterange.db",
+		"time_of_day.format", "time_of_day.range", "timeofday.json", "timeofday.db",
+		"weekly_schedule.weekday", "weekly_schedule.overlap", "weekly_schedule.exception_date", "weekly_schedule.timezone",
+		"weeklyschedule.json", "weeklyschedule.db",
+		"prorata.non_negative_amount", "prorata.change_date", "prorata.convention",
+		"birth_date.required", "birth_date.future", "birth_date.max_age", "birth_date.max_age_config", "birth_date.min_age",
+		"jurisdiction.adult_age",
+		"day.range",
+	}
+	for _, id := range ids {
+		entry, ok := msg.DefaultCatalog.Lookup(id)
+		require.True(t, ok, "entry %s should be registered", id)
+		assert.NotEmpty(t, entry.DocURI)
+		assert.NotEmpty(t, entry.Message)
+	}
+}
+
+func TestCatalog_ResolvesTypesErrors(t *testing.T) {
+	_, err := types.NewPhone("123")
+	var msgErr *msg.MessageError
+	require.True(t, errors.As(err, &msgErr))
+
+	resp := msgErr.ToResponse(msg.WithDocURI(msg.DefaultCatalog))
+	assert.Equal(t, msg.DefaultDocBaseURI+"phone.length", resp.DocURI)
+
+	_, _, err = types.Day(32).ResolveIn(types.MustNewYearMonth(2026, time.October), types.MonthEndClamp)
+	require.True(t, errors.As(err, &msgErr))
+	entry, ok := msg.DefaultCatalog.Resolve(msgErr)
+	require.True(t, ok)
+	assert.Equal(t, "day.range", entry.ID)
+
+	sentinels := []struct {
+		name     string
+		err      error
+		sentinel error
+		id       string
+	}{
+		{"NewDay", second(types.NewDay(0)), types.ErrInvalidDay, "day.range"},
+		{"CalculateProRata billing day", second(types.CalculateProRata(types.ProRataRequest{
+			Amount:     types.MustNewMoney(100, types.BRL),
+			BillingDay: 0,
+			ChangeDate: types.MustNewDate(2026, time.October, 18),
+		})), types.ErrInvalidDay, "day.range"},
+		{"NewCurrency", second(types.NewCurrency("ABC")), types.ErrInvalidCurrency, "currency.invalid"},
+		{"NewCurrencyFromNumericCode", second(types.NewCurrencyFromNumericCode("000")), types.ErrInvalidCurrency, "currency.invalid"},
+		{"SetAllowedCurrencies unknown", types.SetAllowedCurrencies("ABC"), types.ErrInvalidCurrency, "currency.iso4217"},
+		{"SetAllowedCurrencies empty", types.SetAllowedCurrencies(), types.ErrInvalidCurrency, "currency.allowed_list"},
+		{"Currency scan", new(types.Currency).Scan(42), types.ErrInvalidCurrency, "currency.db"},
+	}
+	for _, tc := range sentinels {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.err, tc.sentinel)
+			var msgErr *msg.MessageError
+			require.True(t, errors.As(tc.err, &msgErr))
+			entry, ok := msg.DefaultCatalog.Resolve(msgErr)
+			require.True(t, ok)
+			assert.Equal(t, tc.id, entry.ID)
+		})
+	}
+}
+
+func second[T any](_ T, err error) error {
+	return err
+}
@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+var clockNow = time.Date(2026, time.October, 16, 13, 30, 0, 0, time.UTC)
+
+func TestFixedClock(t *testing.T) {
+	clock := types.NewFixedClock(clockNow)
+	assert.Equal(t, clockNow, clock.Now())
+	assert.Equal(t, clockNow, clock.Now(), "fixed clock does not move on its own")
+
+	assert.Equal(t, clockNow.Add(time.Hour), clock.Advance(time.Hour))
+	assert.Equal(t, clockNow.Add(time.Hour), clock.Now())
+
+	clock.Set(clockNow)
+	assert.Equal(t, clockNow, clock.Now())
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			clock.Advance(time.Second)
+			_ = clock.Now()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, clockNow.Add(10*time.Second), clock.Now())
+}
+
+func TestSystemClock(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	assert.WithinDuration(t, time.Now(), types.SystemClock{}.Now(), time.Second)
+	now := types.NewSystemClock(saoPaulo).Now()
+	assert.Equal(t, saoPaulo, now.Location())
+	assert.WithinDuration(t, time.Now(), now, time.Second)
+}
+
+func TestClockIn(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	now := types.ClockIn(types.NewFixedClock(clockNow), saoPaulo).Now()
+	assert.True(t, now.Equal(clockNow))
+	assert.Equal(t, saoPaulo, now.Location())
+	assert.Equal(t, 10, now.Hour())
+}
+
+func TestClockFunc(t *testing.T) {
+	clock := types.ClockFunc(func() time.Time { return clockNow })
+	assert.Equal(t, clockNow, clock.Now())
+}
+
+func TestSetDefaultClock(t *testing.T) {
+	clock := types.NewFixedClock(clockNow)
+	restore := types.SetDefaultClock(clock)
+
+	assert.Equal(t, clockNow, types.NewCreatedAt().Time())
+	assert.Equal(t, clockNow, types.NewUpdatedAt().Time())
+	assert.Equal(t, clockNow, types.NewDeletedAtNow().Time)
+	assert.Equal(t, clockNow, types.NewArchivedAtNow().Time)
+
+	clock.Advance(time.Minute)
+	var deleted types.DeletedAt
+	deleted.SetNow()
+	assert.True(t, deleted.Valid)
+	assert.Equal(t, clockNow.Add(time.Minute), deleted.Time)
+
+	var archived types.ArchivedAt
+	archived.SetNow()
+	assert.True(t, archived.Valid)
+	assert.Equal(t, clockNow.Add(time.Minute), archived.Time)
+
+	restore()
+	assert.WithinDuration(t, time.Now(), types.NewCreatedAt().Time(), time.Second)
+
+	defer types.SetDefaultClock(nil)()
+	assert.IsType(t, types.SystemClock{}, types.DefaultClock())
+}
+
+func TestTimestampConstructorsFromClock(t *testing.T) {
+	clock := types.NewFixedClock(clockNow)
+
+	assert.Equal(t, clockNow, types.NewCreatedAtFrom(clock).Time())
+	assert.Equal(t, clockNow, types.NewUpdatedAtFrom(clock).Time())
+
+	deleted := types.NewDeletedAtFrom(clock)
+	assert.True(t, deleted.Valid)
+	assert.Equal(t, clockNow, deleted.Time)
+
+	archived := types.NewArchivedAtFrom(clock)
+	assert.True(t, archived.Valid)
+	assert.Equal(t, clockNow, archived.Time)
+
+	clock.Advance(24 * time.Hour)
+	deleted.SetNowFrom(clock)
+	archived.SetNowFrom(clock)
+	assert.Equal(t, clockNow.Add(24*time.Hour), deleted.Time)
+	assert.Equal(t, clockNow.Add(24*time.Hour), archived.Time)
+}
+
+func TestClockFromContext(t *testing.T) {
+	clock := types.NewFixedClock(clockNow)
+	ctx := types.ContextWithClock(context.Background(), clock)
+
+	assert.Equal(t, clockNow, types.ClockFromContext(ctx).Now())
+	assert.Equal(t, clockNow, types.NewCreatedAtFrom(types.ClockFromContext(ctx)).Time())
+
+	assert.Equal(t, types.DefaultClock(), types.ClockFromContext(context.Background()))
+}
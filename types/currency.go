@@ -4,11 +4,22 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
+
+	"github.com/marcelofabianov/gobrick/msg"
 )
 
 var ErrInvalidCurrency = fmt.Errorf("invalid currency")
 
+func currencyError(input any, constraint, message string) error {
+	return msg.NewValidationError(ErrInvalidCurrency,
+		msg.ValidationContext{Field: "currency", Input: input, Constraint: constraint}.ContextMap(),
+		message,
+	)
+}
+
 type Currency string
 
 const (
@@ -17,10 +28,89 @@ const (
 	EUR Currency = "EUR"
 )
 
+type CurrencyInfo struct {
+	Code        Currency
+	NumericCode string
+	MinorUnits  int
+	Symbol      string
+	NameEN      string
+	NamePT      string
+}
+
+var (
+	currencyByCode    = make(map[Currency]CurrencyInfo, len(iso4217Currencies))
+	currencyByNumeric = make(map[string]Currency, len(iso4217Currencies))
+
+	allowedCurrenciesMu sync.RWMutex
+	allowedCurrencies   map[Currency]struct{}
+)
+
+func init() {
+	for _, info := range iso4217Currencies {
+		currencyByCode[info.Code] = info
+		currencyByNumeric[info.NumericCode] = info.Code
+	}
+}
+
+// SetAllowedCurrencies restringe as moedas aceitas por NewCurrency e IsValid nesta aplicação.
+func SetAllowedCurrencies(currencies ...Currency) error {
+	allowed := make(map[Currency]struct{}, len(currencies))
+	for _, c := range currencies {
+		c = Currency(strings.ToUpper(string(c)))
+		if !c.IsKnown() {
+			return currencyError(c.String(), "iso4217", fmt.Sprintf("Currency '%s' is not an ISO 4217 currency.", c))
+		}
+		allowed[c] = struct{}{}
+	}
+	if len(allowed) == 0 {
+		return currencyError(nil, "allowed_list", "At least one currency must be allowed.")
+	}
+
+	allowedCurrenciesMu.Lock()
+	defer allowedCurrenciesMu.Unlock()
+	allowedCurrencies = allowed
+	return nil
+}
+
+// ResetAllowedCurrencies volta a aceitar todas as moedas da ISO 4217.
+func ResetAllowedCurrencies() {
+	allowedCurrenciesMu.Lock()
+	defer allowedCurrenciesMu.Unlock()
+	allowedCurrencies = nil
+}
+
+func AllowedCurrencies() []Currency {
+	allowedCurrenciesMu.RLock()
+	defer allowedCurrenciesMu.RUnlock()
+
+	var currencies []Currency
+	if allowedCurrencies == nil {
+		currencies = make([]Currency, 0, len(iso4217Currencies))
+		for _, info := range iso4217Currencies {
+			currencies = append(currencies, info.Code)
+		}
+	} else {
+		currencies = make([]Currency, 0, len(allowedCurrencies))
+		for c := range allowedCurrencies {
+			currencies = append(currencies, c)
+		}
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i] < currencies[j] })
+	return currencies
+}
+
 func NewCurrency(value string) (Currency, error) {
-	c := Currency(strings.ToUpper(value))
+	c := Currency(strings.ToUpper(strings.TrimSpace(value)))
 	if !c.IsValid() {
-		return "", ErrInvalidCurrency
+		return "", currencyError(value, "invalid", fmt.Sprintf("Currency '%s' is not valid.", value))
+	}
+	return c, nil
+}
+
+func NewCurrencyFromNumericCode(code string) (Currency, error) {
+	c, ok := currencyByNumeric[strings.TrimSpace(code)]
+	if !ok || !c.IsValid() {
+		return "", currencyError(code, "invalid", fmt.Sprintf("Currency numeric code '%s' is not valid.", code))
 	}
 	return c, nil
 }
@@ -29,13 +119,50 @@ func (c Currency) String() string {
 	return string(c)
 }
 
+// IsKnown verifica se o código existe na ISO 4217, ignorando as restrições da aplicação.
+func (c Currency) IsKnown() bool {
+	_, ok := currencyByCode[c]
+	return ok
+}
+
 func (c Currency) IsValid() bool {
-	switch c {
-	case BRL, USD, EUR: // CORREÇÃO: Adicionado EUR à lista de moedas válidas.
-		return true
-	default:
+	if !c.IsKnown() {
 		return false
 	}
+	allowedCurrenciesMu.RLock()
+	defer allowedCurrenciesMu.RUnlock()
+	if allowedCurrencies == nil {
+		return true
+	}
+	_, ok := allowedCurrencies[c]
+	return ok
+}
+
+func (c Currency) Info() (CurrencyInfo, bool) {
+	info, ok := currencyByCode[c]
+	return info, ok
+}
+
+// MinorUnits retorna a quantidade de casas decimais da moeda (JPY 0, BRL 2, KWD 3).
+// Moedas desconhecidas retornam 0.
+func (c Currency) MinorUnits() int {
+	return currencyByCode[c].MinorUnits
+}
+
+func (c Currency) Symbol() string {
+	return currencyByCode[c].Symbol
+}
+
+func (c Currency) NumericCode() string {
+	return currencyByCode[c].NumericCode
+}
+
+func (c Currency) NameEN() string {
+	return currencyByCode[c].NameEN
+}
+
+func (c Currency) NamePT() string {
+	return currencyByCode[c].NamePT
 }
 
 func (c Currency) IsEmpty() bool {
@@ -75,7 +202,11 @@ func (c *Currency) Scan(src interface{}) error {
 	case []byte:
 		s = string(v)
 	default:
-		return ErrInvalidCurrency
+		message := fmt.Sprintf("Incompatible type (%T) for Currency. Expected string or []byte.", src)
+		return msg.NewValidationError(ErrInvalidCurrency,
+			msg.ConversionContext{TargetType: "Currency", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
 	}
 
 	*c = Currency(s)
@@ -0,0 +1,184 @@
+package types
+
+// iso4217Currencies lista a ISO 4217 completa, incluindo fundos, metais e códigos especiais.
+// Códigos sem casas decimais definidas pela norma (N.A.: metais, XDR, XTS, XXX) usam 0.
+var iso4217Currencies = []CurrencyInfo{
+	{Code: "AED", NumericCode: "784", MinorUnits: 2, Symbol: "د.إ", NameEN: "UAE Dirham", NamePT: "Dirham dos Emirados Árabes Unidos"},
+	{Code: "AFN", NumericCode: "971", MinorUnits: 2, Symbol: "؋", NameEN: "Afghani", NamePT: "Afegane"},
+	{Code: "ALL", NumericCode: "008", MinorUnits: 2, Symbol: "L", NameEN: "Lek", NamePT: "Lek albanês"},
+	{Code: "AMD", NumericCode: "051", MinorUnits: 2, Symbol: "֏", NameEN: "Armenian Dram", NamePT: "Dram armênio"},
+	{Code: "ANG", NumericCode: "532", MinorUnits: 2, Symbol: "ƒ", NameEN: "Netherlands Antillean Guilder", NamePT: "Florim das Antilhas Neerlandesas"},
+	{Code: "AOA", NumericCode: "973", MinorUnits: 2, Symbol: "Kz", NameEN: "Kwanza", NamePT: "Kwanza angolano"},
+	{Code: "ARS", NumericCode: "032", MinorUnits: 2, Symbol: "$", NameEN: "Argentine Peso", NamePT: "Peso argentino"},
+	{Code: "AUD", NumericCode: "036", MinorUnits: 2, Symbol: "A$", NameEN: "Australian Dollar", NamePT: "Dólar australiano"},
+	{Code: "AWG", NumericCode: "533", MinorUnits: 2, Symbol: "ƒ", NameEN: "Aruban Florin", NamePT: "Florim arubano"},
+	{Code: "AZN", NumericCode: "944", MinorUnits: 2, Symbol: "₼", NameEN: "Azerbaijan Manat", NamePT: "Manat azeri"},
+	{Code: "BAM", NumericCode: "977", MinorUnits: 2, Symbol: "KM", NameEN: "Convertible Mark", NamePT: "Marco conversível"},
+	{Code: "BBD", NumericCode: "052", MinorUnits: 2, Symbol: "Bds$", NameEN: "Barbados Dollar", NamePT: "Dólar de Barbados"},
+	{Code: "BDT", NumericCode: "050", MinorUnits: 2, Symbol: "৳", NameEN: "Taka", NamePT: "Taka"},
+	{Code: "BGN", NumericCode: "975", MinorUnits: 2, Symbol: "лв", NameEN: "Bulgarian Lev", NamePT: "Lev búlgaro"},
+	{Code: "BHD", NumericCode: "048", MinorUnits: 3, Symbol: ".د.ب", NameEN: "Bahraini Dinar", NamePT: "Dinar bareinita"},
+	{Code: "BIF", NumericCode: "108", MinorUnits: 0, Symbol: "FBu", NameEN: "Burundi Franc", NamePT: "Franco do Burundi"},
+	{Code: "BMD", NumericCode: "060", MinorUnits: 2, Symbol: "$", NameEN: "Bermudian Dollar", NamePT: "Dólar das Bermudas"},
+	{Code: "BND", NumericCode: "096", MinorUnits: 2, Symbol: "B$", NameEN: "Brunei Dollar", NamePT: "Dólar de Brunei"},
+	{Code: "BOB", NumericCode: "068", MinorUnits: 2, Symbol: "Bs.", NameEN: "Boliviano", NamePT: "Boliviano"},
+	{Code: "BOV", NumericCode: "984", MinorUnits: 2, Symbol: "Bs.", NameEN: "Mvdol", NamePT: "Mvdol boliviano"},
+	{Code: "BRL", NumericCode: "986", MinorUnits: 2, Symbol: "R$", NameEN: "Brazilian Real", NamePT: "Real brasileiro"},
+	{Code: "BSD", NumericCode: "044", MinorUnits: 2, Symbol: "B$", NameEN: "Bahamian Dollar", NamePT: "Dólar bahamense"},
+	{Code: "BTN", NumericCode: "064", MinorUnits: 2, Symbol: "Nu.", NameEN: "Ngultrum", NamePT: "Ngultrum"},
+	{Code: "BWP", NumericCode: "072", MinorUnits: 2, Symbol: "P", NameEN: "Pula", NamePT: "Pula"},
+	{Code: "BYN", NumericCode: "933", MinorUnits: 2, Symbol: "Br", NameEN: "Belarusian Ruble", NamePT: "Rublo bielorrusso"},
+	{Code: "BZD", NumericCode: "084", MinorUnits: 2, Symbol: "BZ$", NameEN: "Belize Dollar", NamePT: "Dólar de Belize"},
+	{Code: "CAD", NumericCode: "124", MinorUnits: 2, Symbol: "CA$", NameEN: "Canadian Dollar", NamePT: "Dólar canadense"},
+	{Code: "CDF", NumericCode: "976", MinorUnits: 2, Symbol: "FC", NameEN: "Congolese Franc", NamePT: "Franco congolês"},
+	{Code: "CHE", NumericCode: "947", MinorUnits: 2, Symbol: "CHE", NameEN: "WIR Euro", NamePT: "Euro WIR"},
+	{Code: "CHF", NumericCode: "756", MinorUnits: 2, Symbol: "CHF", NameEN: "Swiss Franc", NamePT: "Franco suíço"},
+	{Code: "CHW", NumericCode: "948", MinorUnits: 2, Symbol: "CHW", NameEN: "WIR Franc", NamePT: "Franco WIR"},
+	{Code: "CLF", NumericCode: "990", MinorUnits: 4, Symbol: "UF", NameEN: "Unidad de Fomento", NamePT: "Unidade de Fomento chilena"},
+	{Code: "CLP", NumericCode: "152", MinorUnits: 0, Symbol: "$", NameEN: "Chilean Peso", NamePT: "Peso chileno"},
+	{Code: "CNY", NumericCode: "156", MinorUnits: 2, Symbol: "¥", NameEN: "Yuan Renminbi", NamePT: "Yuan chinês"},
+	{Code: "COP", NumericCode: "170", MinorUnits: 2, Symbol: "$", NameEN: "Colombian Peso", NamePT: "Peso colombiano"},
+	{Code: "COU", NumericCode: "970", MinorUnits: 2, Symbol: "COU", NameEN: "Unidad de Valor Real", NamePT: "Unidade de Valor Real colombiana"},
+	{Code: "CRC", NumericCode: "188", MinorUnits: 2, Symbol: "₡", NameEN: "Costa Rican Colon", NamePT: "Colón costarriquenho"},
+	{Code: "CUP", NumericCode: "192", MinorUnits: 2, Symbol: "$", NameEN: "Cuban Peso", NamePT: "Peso cubano"},
+	{Code: "CVE", NumericCode: "132", MinorUnits: 2, Symbol: "Esc", NameEN: "Cabo Verde Escudo", NamePT: "Escudo cabo-verdiano"},
+	{Code: "CZK", NumericCode: "203", MinorUnits: 2, Symbol: "Kč", NameEN: "Czech Koruna", NamePT: "Coroa checa"},
+	{Code: "DJF", NumericCode: "262", MinorUnits: 0, Symbol: "Fdj", NameEN: "Djibouti Franc", NamePT: "Franco do Djibuti"},
+	{Code: "DKK", NumericCode: "208", MinorUnits: 2, Symbol: "kr", NameEN: "Danish Krone", NamePT: "Coroa dinamarquesa"},
+	{Code: "DOP", NumericCode: "214", MinorUnits: 2, Symbol: "RD$", NameEN: "Dominican Peso", NamePT: "Peso dominicano"},
+	{Code: "DZD", NumericCode: "012", MinorUnits: 2, Symbol: "د.ج", NameEN: "Algerian Dinar", NamePT: "Dinar argelino"},
+	{Code: "EGP", NumericCode: "818", MinorUnits: 2, Symbol: "E£", NameEN: "Egyptian Pound", NamePT: "Libra egípcia"},
+	{Code: "ERN", NumericCode: "232", MinorUnits: 2, Symbol: "Nfk", NameEN: "Nakfa", NamePT: "Nakfa"},
+	{Code: "ETB", NumericCode: "230", MinorUnits: 2, Symbol: "Br", NameEN: "Ethiopian Birr", NamePT: "Birr etíope"},
+	{Code: "EUR", NumericCode: "978", MinorUnits: 2, Symbol: "€", NameEN: "Euro", NamePT: "Euro"},
+	{Code: "FJD", NumericCode: "242", MinorUnits: 2, Symbol: "FJ$", NameEN: "Fiji Dollar", NamePT: "Dólar fijiano"},
+	{Code: "FKP", NumericCode: "238", MinorUnits: 2, Symbol: "£", NameEN: "Falkland Islands Pound", NamePT: "Libra das Ilhas Malvinas"},
+	{Code: "GBP", NumericCode: "826", MinorUnits: 2, Symbol: "£", NameEN: "Pound Sterling", NamePT: "Libra esterlina"},
+	{Code: "GEL", NumericCode: "981", MinorUnits: 2, Symbol: "₾", NameEN: "Lari", NamePT: "Lari georgiano"},
+	{Code: "GHS", NumericCode: "936", MinorUnits: 2, Symbol: "GH₵", NameEN: "Ghana Cedi", NamePT: "Cedi ganês"},
+	{Code: "GIP", NumericCode: "292", MinorUnits: 2, Symbol: "£", NameEN: "Gibraltar Pound", NamePT: "Libra de Gibraltar"},
+	{Code: "GMD", NumericCode: "270", MinorUnits: 2, Symbol: "D", NameEN: "Dalasi", NamePT: "Dalasi"},
+	{Code: "GNF", NumericCode: "324", MinorUnits: 0, Symbol: "FG", NameEN: "Guinean Franc", NamePT: "Franco guineense"},
+	{Code: "GTQ", NumericCode: "320", MinorUnits: 2, Symbol: "Q", NameEN: "Quetzal", NamePT: "Quetzal"},
+	{Code: "GYD", NumericCode: "328", MinorUnits: 2, Symbol: "G$", NameEN: "Guyana Dollar", NamePT: "Dólar guianense"},
+	{Code: "HKD", NumericCode: "344", MinorUnits: 2, Symbol: "HK$", NameEN: "Hong Kong Dollar", NamePT: "Dólar de Hong Kong"},
+	{Code: "HNL", NumericCode: "340", MinorUnits: 2, Symbol: "L", NameEN: "Lempira", NamePT: "Lempira"},
+	{Code: "HTG", NumericCode: "332", MinorUnits: 2, Symbol: "G", NameEN: "Gourde", NamePT: "Gourde"},
+	{Code: "HUF", NumericCode: "348", MinorUnits: 2, Symbol: "Ft", NameEN: "Forint", NamePT: "Florim húngaro"},
+	{Code: "IDR", NumericCode: "360", MinorUnits: 2, Symbol: "Rp", NameEN: "Rupiah", NamePT: "Rupia indonésia"},
+	{Code: "ILS", NumericCode: "376", MinorUnits: 2, Symbol: "₪", NameEN: "New Israeli Sheqel", NamePT: "Novo shekel israelense"},
+	{Code: "INR", NumericCode: "356", MinorUnits: 2, Symbol: "₹", NameEN: "Indian Rupee", NamePT: "Rupia indiana"},
+	{Code: "IQD", NumericCode: "368", MinorUnits: 3, Symbol: "ع.د", NameEN: "Iraqi Dinar", NamePT: "Dinar iraquiano"},
+	{Code: "IRR", NumericCode: "364", MinorUnits: 2, Symbol: "﷼", NameEN: "Iranian Rial", NamePT: "Rial iraniano"},
+	{Code: "ISK", NumericCode: "352", MinorUnits: 0, Symbol: "kr", NameEN: "Iceland Krona", NamePT: "Coroa islandesa"},
+	{Code: "JMD", NumericCode: "388", MinorUnits: 2, Symbol: "J$", NameEN: "Jamaican Dollar", NamePT: "Dólar jamaicano"},
+	{Code: "JOD", NumericCode: "400", MinorUnits: 3, Symbol: "د.ا", NameEN: "Jordanian Dinar", NamePT: "Dinar jordaniano"},
+	{Code: "JPY", NumericCode: "392", MinorUnits: 0, Symbol: "¥", NameEN: "Yen", NamePT: "Iene"},
+	{Code: "KES", NumericCode: "404", MinorUnits: 2, Symbol: "KSh", NameEN: "Kenyan Shilling", NamePT: "Xelim queniano"},
+	{Code: "KGS", NumericCode: "417", MinorUnits: 2, Symbol: "сом", NameEN: "Som", NamePT: "Som quirguiz"},
+	{Code: "KHR", NumericCode: "116", MinorUnits: 2, Symbol: "៛", NameEN: "Riel", NamePT: "Riel cambojano"},
+	{Code: "KMF", NumericCode: "174", MinorUnits: 0, Symbol: "CF", NameEN: "Comorian Franc", NamePT: "Franco comoriano"},
+	{Code: "KPW", NumericCode: "408", MinorUnits: 2, Symbol: "₩", NameEN: "North Korean Won", NamePT: "Won norte-coreano"},
+	{Code: "KRW", NumericCode: "410", MinorUnits: 0, Symbol: "₩", NameEN: "Won", NamePT: "Won sul-coreano"},
+	{Code: "KWD", NumericCode: "414", MinorUnits: 3, Symbol: "د.ك", NameEN: "Kuwaiti Dinar", NamePT: "Dinar kuwaitiano"},
+	{Code: "KYD", NumericCode: "136", MinorUnits: 2, Symbol: "CI$", NameEN: "Cayman Islands Dollar", NamePT: "Dólar das Ilhas Cayman"},
+	{Code: "KZT", NumericCode: "398", MinorUnits: 2, Symbol: "₸", NameEN: "Tenge", NamePT: "Tenge"},
+	{Code: "LAK", NumericCode: "418", MinorUnits: 2, Symbol: "₭", NameEN: "Lao Kip", NamePT: "Kip laosiano"},
+	{Code: "LBP", NumericCode: "422", MinorUnits: 2, Symbol: "ل.ل", NameEN: "Lebanese Pound", NamePT: "Libra libanesa"},
+	{Code: "LKR", NumericCode: "144", MinorUnits: 2, Symbol: "Rs", NameEN: "Sri Lanka Rupee", NamePT: "Rupia do Sri Lanka"},
+	{Code: "LRD", NumericCode: "430", MinorUnits: 2, Symbol: "L$", NameEN: "Liberian Dollar", NamePT: "Dólar liberiano"},
+	{Code: "LSL", NumericCode: "426", MinorUnits: 2, Symbol: "L", NameEN: "Loti", NamePT: "Loti"},
+	{Code: "LYD", NumericCode: "434", MinorUnits: 3, Symbol: "ل.د", NameEN: "Libyan Dinar", NamePT: "Dinar líbio"},
+	{Code: "MAD", NumericCode: "504", MinorUnits: 2, Symbol: "د.م.", NameEN: "Moroccan Dirham", NamePT: "Dirham marroquino"},
+	{Code: "MDL", NumericCode: "498", MinorUnits: 2, Symbol: "L", NameEN: "Moldovan Leu", NamePT: "Leu moldávio"},
+	{Code: "MGA", NumericCode: "969", MinorUnits: 2, Symbol: "Ar", NameEN: "Malagasy Ariary", NamePT: "Ariary malgaxe"},
+	{Code: "MKD", NumericCode: "807", MinorUnits: 2, Symbol: "ден", NameEN: "Denar", NamePT: "Dinar macedônio"},
+	{Code: "MMK", NumericCode: "104", MinorUnits: 2, Symbol: "K", NameEN: "Kyat", NamePT: "Kyat"},
+	{Code: "MNT", NumericCode: "496", MinorUnits: 2, Symbol: "₮", NameEN: "Tugrik", NamePT: "Tugrik"},
+	{Code: "MOP", NumericCode: "446", MinorUnits: 2, Symbol: "MOP$", NameEN: "Pataca", NamePT: "Pataca"},
+	{Code: "MRU", NumericCode: "929", MinorUnits: 2, Symbol: "UM", NameEN: "Ouguiya", NamePT: "Uguia"},
+	{Code: "MUR", NumericCode: "480", MinorUnits: 2, Symbol: "₨", NameEN: "Mauritius Rupee", NamePT: "Rupia mauriciana"},
+	{Code: "MVR", NumericCode: "462", MinorUnits: 2, Symbol: "Rf", NameEN: "Rufiyaa", NamePT: "Rupia maldívia"},
+	{Code: "MWK", NumericCode: "454", MinorUnits: 2, Symbol: "MK", NameEN: "Malawi Kwacha", NamePT: "Kwacha malauiano"},
+	{Code: "MXN", NumericCode: "484", MinorUnits: 2, Symbol: "$", NameEN: "Mexican Peso", NamePT: "Peso mexicano"},
+	{Code: "MXV", NumericCode: "979", MinorUnits: 2, Symbol: "UDI", NameEN: "Mexican Unidad de Inversion (UDI)", NamePT: "Unidade de Investimento mexicana (UDI)"},
+	{Code: "MYR", NumericCode: "458", MinorUnits: 2, Symbol: "RM", NameEN: "Malaysian Ringgit", NamePT: "Ringgit malaio"},
+	{Code: "MZN", NumericCode: "943", MinorUnits: 2, Symbol: "MT", NameEN: "Mozambique Metical", NamePT: "Metical moçambicano"},
+	{Code: "NAD", NumericCode: "516", MinorUnits: 2, Symbol: "N$", NameEN: "Namibia Dollar", NamePT: "Dólar namibiano"},
+	{Code: "NGN", NumericCode: "566", MinorUnits: 2, Symbol: "₦", NameEN: "Naira", NamePT: "Naira"},
+	{Code: "NIO", NumericCode: "558", MinorUnits: 2, Symbol: "C$", NameEN: "Cordoba Oro", NamePT: "Córdoba"},
+	{Code: "NOK", NumericCode: "578", MinorUnits: 2, Symbol: "kr", NameEN: "Norwegian Krone", NamePT: "Coroa norueguesa"},
+	{Code: "NPR", NumericCode: "524", MinorUnits: 2, Symbol: "रू", NameEN: "Nepalese Rupee", NamePT: "Rupia nepalesa"},
+	{Code: "NZD", NumericCode: "554", MinorUnits: 2, Symbol: "NZ$", NameEN: "New Zealand Dollar", NamePT: "Dólar neozelandês"},
+	{Code: "OMR", NumericCode: "512", MinorUnits: 3, Symbol: "ر.ع.", NameEN: "Rial Omani", NamePT: "Rial omanense"},
+	{Code: "PAB", NumericCode: "590", MinorUnits: 2, Symbol: "B/.", NameEN: "Balboa", NamePT: "Balboa"},
+	{Code: "PEN", NumericCode: "604", MinorUnits: 2, Symbol: "S/", NameEN: "Sol", NamePT: "Sol peruano"},
+	{Code: "PGK", NumericCode: "598", MinorUnits: 2, Symbol: "K", NameEN: "Kina", NamePT: "Kina"},
+	{Code: "PHP", NumericCode: "608", MinorUnits: 2, Symbol: "₱", NameEN: "Philippine Peso", NamePT: "Peso filipino"},
+	{Code: "PKR", NumericCode: "586", MinorUnits: 2, Symbol: "₨", NameEN: "Pakistan Rupee", NamePT: "Rupia paquistanesa"},
+	{Code: "PLN", NumericCode: "985", MinorUnits: 2, Symbol: "zł", NameEN: "Zloty", NamePT: "Zloty"},
+	{Code: "PYG", NumericCode: "600", MinorUnits: 0, Symbol: "₲", NameEN: "Guarani", NamePT: "Guarani"},
+	{Code: "QAR", NumericCode: "634", MinorUnits: 2, Symbol: "ر.ق", NameEN: "Qatari Rial", NamePT: "Rial catariano"},
+	{Code: "RON", NumericCode: "946", MinorUnits: 2, Symbol: "lei", NameEN: "Romanian Leu", NamePT: "Leu romeno"},
+	{Code: "RSD", NumericCode: "941", MinorUnits: 2, Symbol: "дин.", NameEN: "Serbian Dinar", NamePT: "Dinar sérvio"},
+	{Code: "RUB", NumericCode: "643", MinorUnits: 2, Symbol: "₽", NameEN: "Russian Ruble", NamePT: "Rublo russo"},
+	{Code: "RWF", NumericCode: "646", MinorUnits: 0, Symbol: "FRw", NameEN: "Rwanda Franc", NamePT: "Franco ruandês"},
+	{Code: "SAR", NumericCode: "682", MinorUnits: 2, Symbol: "ر.س", NameEN: "Saudi Riyal", NamePT: "Rial saudita"},
+	{Code: "SBD", NumericCode: "090", MinorUnits: 2, Symbol: "SI$", NameEN: "Solomon Islands Dollar", NamePT: "Dólar das Ilhas Salomão"},
+	{Code: "SCR", NumericCode: "690", MinorUnits: 2, Symbol: "₨", NameEN: "Seychelles Rupee", NamePT: "Rupia seichelense"},
+	{Code: "SDG", NumericCode: "938", MinorUnits: 2, Symbol: "ج.س.", NameEN: "Sudanese Pound", NamePT: "Libra sudanesa"},
+	{Code: "SEK", NumericCode: "752", MinorUnits: 2, Symbol: "kr", NameEN: "Swedish Krona", NamePT: "Coroa sueca"},
+	{Code: "SGD", NumericCode: "702", MinorUnits: 2, Symbol: "S$", NameEN: "Singapore Dollar", NamePT: "Dólar de Singapura"},
+	{Code: "SHP", NumericCode: "654", MinorUnits: 2, Symbol: "£", NameEN: "Saint Helena Pound", NamePT: "Libra de Santa Helena"},
+	{Code: "SLE", NumericCode: "925", MinorUnits: 2, Symbol: "Le", NameEN: "Leone", NamePT: "Leone"},
+	{Code: "SOS", NumericCode: "706", MinorUnits: 2, Symbol: "Sh", NameEN: "Somali Shilling", NamePT: "Xelim somali"},
+	{Code: "SRD", NumericCode: "968", MinorUnits: 2, Symbol: "$", NameEN: "Surinam Dollar", NamePT: "Dólar surinamês"},
+	{Code: "SSP", NumericCode: "728", MinorUnits: 2, Symbol: "£", NameEN: "South Sudanese Pound", NamePT: "Libra sul-sudanesa"},
+	{Code: "STN", NumericCode: "930", MinorUnits: 2, Symbol: "Db", NameEN: "Dobra", NamePT: "Dobra"},
+	{Code: "SVC", NumericCode: "222", MinorUnits: 2, Symbol: "₡", NameEN: "El Salvador Colon", NamePT: "Colón salvadorenho"},
+	{Code: "SYP", NumericCode: "760", MinorUnits: 2, Symbol: "£S", NameEN: "Syrian Pound", NamePT: "Libra síria"},
+	{Code: "SZL", NumericCode: "748", MinorUnits: 2, Symbol: "E", NameEN: "Lilangeni", NamePT: "Lilangeni"},
+	{Code: "THB", NumericCode: "764", MinorUnits: 2, Symbol: "฿", NameEN: "Baht", NamePT: "Baht"},
+	{Code: "TJS", NumericCode: "972", MinorUnits: 2, Symbol: "SM", NameEN: "Somoni", NamePT: "Somoni"},
+	{Code: "TMT", NumericCode: "934", MinorUnits: 2, Symbol: "m", NameEN: "Turkmenistan New Manat", NamePT: "Manat turcomeno"},
+	{Code: "TND", NumericCode: "788", MinorUnits: 3, Symbol: "د.ت", NameEN: "Tunisian Dinar", NamePT: "Dinar tunisiano"},
+	{Code: "TOP", NumericCode: "776", MinorUnits: 2, Symbol: "T$", NameEN: "Pa'anga", NamePT: "Paʻanga"},
+	{Code: "TRY", NumericCode: "949", MinorUnits: 2, Symbol: "₺", NameEN: "Turkish Lira", NamePT: "Lira turca"},
+	{Code: "TTD", NumericCode: "780", MinorUnits: 2, Symbol: "TT$", NameEN: "Trinidad and Tobago Dollar", NamePT: "Dólar de Trinidad e Tobago"},
+	{Code: "TWD", NumericCode: "901", MinorUnits: 2, Symbol: "NT$", NameEN: "New Taiwan Dollar", NamePT: "Novo dólar taiwanês"},
+	{Code: "TZS", NumericCode: "834", MinorUnits: 2, Symbol: "TSh", NameEN: "Tanzanian Shilling", NamePT: "Xelim tanzaniano"},
+	{Code: "UAH", NumericCode: "980", MinorUnits: 2, Symbol: "₴", NameEN: "Hryvnia", NamePT: "Grívnia"},
+	{Code: "UGX", NumericCode: "800", MinorUnits: 0, Symbol: "USh", NameEN: "Uganda Shilling", NamePT: "Xelim ugandense"},
+	{Code: "USD", NumericCode: "840", MinorUnits: 2, Symbol: "$", NameEN: "US Dollar", NamePT: "Dólar americano"},
+	{Code: "USN", NumericCode: "997", MinorUnits: 2, Symbol: "$", NameEN: "US Dollar (Next day)", NamePT: "Dólar americano (dia seguinte)"},
+	{Code: "UYI", NumericCode: "940", MinorUnits: 0, Symbol: "UI", NameEN: "Uruguay Peso en Unidades Indexadas (UI)", NamePT: "Peso uruguaio em Unidades Indexadas (UI)"},
+	{Code: "UYU", NumericCode: "858", MinorUnits: 2, Symbol: "$U", NameEN: "Peso Uruguayo", NamePT: "Peso uruguaio"},
+	{Code: "UYW", NumericCode: "927", MinorUnits: 4, Symbol: "UP", NameEN: "Unidad Previsional", NamePT: "Unidade Previsional uruguaia"},
+	{Code: "UZS", NumericCode: "860", MinorUnits: 2, Symbol: "soʻm", NameEN: "Uzbekistan Sum", NamePT: "Som uzbeque"},
+	{Code: "VED", NumericCode: "926", MinorUnits: 2, Symbol: "Bs.D", NameEN: "Bolívar Soberano", NamePT: "Bolívar soberano digital"},
+	{Code: "VES", NumericCode: "928", MinorUnits: 2, Symbol: "Bs.S", NameEN: "Bolívar Soberano", NamePT: "Bolívar soberano"},
+	{Code: "VND", NumericCode: "704", MinorUnits: 0, Symbol: "₫", NameEN: "Dong", NamePT: "Dong vietnamita"},
+	{Code: "VUV", NumericCode: "548", MinorUnits: 0, Symbol: "VT", NameEN: "Vatu", NamePT: "Vatu"},
+	{Code: "WST", NumericCode: "882", MinorUnits: 2, Symbol: "WS$", NameEN: "Tala", NamePT: "Tala"},
+	{Code: "XAF", NumericCode: "950", MinorUnits: 0, Symbol: "FCFA", NameEN: "CFA Franc BEAC", NamePT: "Franco CFA BEAC"},
+	{Code: "XAG", NumericCode: "961", MinorUnits: 0, Symbol: "XAG", NameEN: "Silver", NamePT: "Prata"},
+	{Code: "XAU", NumericCode: "959", MinorUnits: 0, Symbol: "XAU", NameEN: "Gold", NamePT: "Ouro"},
+	{Code: "XBA", NumericCode: "955", MinorUnits: 0, Symbol: "XBA", NameEN: "Bond Markets Unit European Composite Unit (EURCO)", NamePT: "Unidade composta europeia (EURCO)"},
+	{Code: "XBB", NumericCode: "956", MinorUnits: 0, Symbol: "XBB", NameEN: "Bond Markets Unit European Monetary Unit (E.M.U.-6)", NamePT: "Unidade monetária europeia (E.M.U.-6)"},
+	{Code: "XBC", NumericCode: "957", MinorUnits: 0, Symbol: "XBC", NameEN: "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)", NamePT: "Unidade de conta europeia 9 (E.U.A.-9)"},
+	{Code: "XBD", NumericCode: "958", MinorUnits: 0, Symbol: "XBD", NameEN: "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)", NamePT: "Unidade de conta europeia 17 (E.U.A.-17)"},
+	{Code: "XCD", NumericCode: "951", MinorUnits: 2, Symbol: "EC$", NameEN: "East Caribbean Dollar", NamePT: "Dólar do Caribe Oriental"},
+	{Code: "XDR", NumericCode: "960", MinorUnits: 0, Symbol: "SDR", NameEN: "SDR (Special Drawing Right)", NamePT: "Direito Especial de Saque (DES)"},
+	{Code: "XOF", NumericCode: "952", MinorUnits: 0, Symbol: "CFA", NameEN: "CFA Franc BCEAO", NamePT: "Franco CFA BCEAO"},
+	{Code: "XPD", NumericCode: "964", MinorUnits: 0, Symbol: "XPD", NameEN: "Palladium", NamePT: "Paládio"},
+	{Code: "XPF", NumericCode: "953", MinorUnits: 0, Symbol: "₣", NameEN: "CFP Franc", NamePT: "Franco CFP"},
+	{Code: "XPT", NumericCode: "962", MinorUnits: 0, Symbol: "XPT", NameEN: "Platinum", NamePT: "Platina"},
+	{Code: "XSU", NumericCode: "994", MinorUnits: 0, Symbol: "Sucre", NameEN: "Sucre", NamePT: "Sucre"},
+	{Code: "XTS", NumericCode: "963", MinorUnits: 0, Symbol: "XTS", NameEN: "Codes specifically reserved for testing purposes", NamePT: "Código reservado para testes"},
+	{Code: "XUA", NumericCode: "965", MinorUnits: 0, Symbol: "XUA", NameEN: "ADB Unit of Account", NamePT: "Unidade de conta do BAD"},
+	{Code: "XXX", NumericCode: "999", MinorUnits: 0, Symbol: "XXX", NameEN: "The codes assigned for transactions where no currency is involved", NamePT: "Transações sem moeda envolvida"},
+	{Code: "YER", NumericCode: "886", MinorUnits: 2, Symbol: "﷼", NameEN: "Yemeni Rial", NamePT: "Rial iemenita"},
+	{Code: "ZAR", NumericCode: "710", MinorUnits: 2, Symbol: "R", NameEN: "Rand", NamePT: "Rand sul-africano"},
+	{Code: "ZMW", NumericCode: "967", MinorUnits: 2, Symbol: "ZK", NameEN: "Zambian Kwacha", NamePT: "Kwacha zambiano"},
+	{Code: "ZWG", NumericCode: "924", MinorUnits: 2, Symbol: "ZiG", NameEN: "Zimbabwe Gold", NamePT: "Ouro do Zimbábue"},
+}
@@ -39,9 +39,15 @@ func TestNewCurrency(t *testing.T) {
 			expected:    EUR,
 			expectError: false,
 		},
+		{
+			name:        "should create GBP currency from the ISO 4217 table",
+			inputValue:  "GBP",
+			expected:    Currency("GBP"),
+			expectError: false,
+		},
 		{
 			name:          "should return error for invalid currency",
-			inputValue:    "GBP",
+			inputValue:    "ABC",
 			expectError:   true,
 			expectedError: ErrInvalidCurrency,
 		},
@@ -68,3 +74,90 @@ func TestNewCurrency(t *testing.T) {
 		})
 	}
 }
+
+func TestCurrency_Info(t *testing.T) {
+	testCases := []struct {
+		currency    Currency
+		numericCode string
+		minorUnits  int
+		symbol      string
+		nameEN      string
+		namePT      string
+	}{
+		{BRL, "986", 2, "R$", "Brazilian Real", "Real brasileiro"},
+		{USD, "840", 2, "$", "US Dollar", "Dólar americano"},
+		{EUR, "978", 2, "€", "Euro", "Euro"},
+		{Currency("JPY"), "392", 0, "¥", "Yen", "Iene"},
+		{Currency("KWD"), "414", 3, "د.ك", "Kuwaiti Dinar", "Dinar kuwaitiano"},
+		{Currency("CLF"), "990", 4, "UF", "Unidad de Fomento", "Unidade de Fomento chilena"},
+		{Currency("XAU"), "959", 0, "XAU", "Gold", "Ouro"},
+		{Currency("XXX"), "999", 0, "XXX", "The codes assigned for transactions where no currency is involved", "Transações sem moeda envolvida"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.currency.String(), func(t *testing.T) {
+			assert.Equal(t, tc.numericCode, tc.currency.NumericCode())
+			assert.Equal(t, tc.minorUnits, tc.currency.MinorUnits())
+			assert.Equal(t, tc.symbol, tc.currency.Symbol())
+			assert.Equal(t, tc.nameEN, tc.currency.NameEN())
+			assert.Equal(t, tc.namePT, tc.currency.NamePT())
+
+			info, ok := tc.currency.Info()
+			require.True(t, ok)
+			assert.Equal(t, tc.currency, info.Code)
+		})
+	}
+
+	_, ok := Currency("ABC").Info()
+	assert.False(t, ok)
+	assert.Equal(t, 0, Currency("ABC").MinorUnits())
+}
+
+func TestNewCurrencyFromNumericCode(t *testing.T) {
+	c, err := NewCurrencyFromNumericCode("986")
+	require.NoError(t, err)
+	assert.Equal(t, BRL, c)
+
+	_, err = NewCurrencyFromNumericCode("000")
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+}
+
+func TestISO4217Table(t *testing.T) {
+	codes := make(map[Currency]bool)
+	numerics := make(map[string]bool)
+	for _, info := range iso4217Currencies {
+		assert.Len(t, info.Code.String(), 3, "code %s", info.Code)
+		assert.Len(t, info.NumericCode, 3, "numeric code of %s", info.Code)
+		assert.Contains(t, []int{0, 2, 3, 4}, info.MinorUnits, "minor units of %s", info.Code)
+		assert.NotEmpty(t, info.Symbol, "symbol of %s", info.Code)
+		assert.NotEmpty(t, info.NameEN, "english name of %s", info.Code)
+		assert.NotEmpty(t, info.NamePT, "portuguese name of %s", info.Code)
+		assert.False(t, codes[info.Code], "duplicated code %s", info.Code)
+		assert.False(t, numerics[info.NumericCode], "duplicated numeric code %s", info.NumericCode)
+		codes[info.Code] = true
+		numerics[info.NumericCode] = true
+	}
+}
+
+func TestSetAllowedCurrencies(t *testing.T) {
+	t.Cleanup(ResetAllowedCurrencies)
+
+	require.NoError(t, SetAllowedCurrencies(BRL, "usd"))
+
+	assert.True(t, BRL.IsValid())
+	assert.True(t, USD.IsValid())
+	assert.False(t, EUR.IsValid())
+	assert.True(t, EUR.IsKnown())
+	assert.Equal(t, []Currency{BRL, USD}, AllowedCurrencies())
+
+	_, err := NewCurrency("EUR")
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+
+	assert.ErrorIs(t, SetAllowedCurrencies(Currency("ABC")), ErrInvalidCurrency)
+	assert.ErrorIs(t, SetAllowedCurrencies(), ErrInvalidCurrency)
+	assert.Equal(t, []Currency{BRL, USD}, AllowedCurrencies(), "failed calls keep the previous restriction")
+
+	ResetAllowedCurrencies()
+	assert.True(t, EUR.IsValid())
+	assert.Len(t, AllowedCurrencies(), len(iso4217Currencies))
+}
@@ -0,0 +1,267 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+const (
+	DateLayoutISO = "2006-01-02"
+	DateLayoutBR  = "02/01/2006"
+)
+
+// MonthEndPolicy define o que acontece quando o dia não existe no mês de destino.
+type MonthEndPolicy string
+
+const (
+	// MonthEndClamp usa o último dia do mês: 31/01 + 1 mês = 28/02.
+	MonthEndClamp MonthEndPolicy = "clamp"
+	// MonthEndRollover transborda para o mês seguinte, como time.AddDate: 31/01 + 1 mês = 03/03.
+	MonthEndRollover MonthEndPolicy = "rollover"
+	// MonthEndSkip ignora meses em que o dia não existe; vale apenas na resolução de Day.
+	MonthEndSkip MonthEndPolicy = "skip"
+)
+
+// Date é uma data civil (ano, mês, dia) sem horário nem fuso. O valor zero
+// representa data ausente e é serializado como null/NULL.
+type Date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dateError(input any, constraint, message string) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "date", Input: input, Constraint: constraint}.ContextMap(),
+		message,
+	)
+}
+
+func NewDate(year int, month time.Month, day int) (Date, error) {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if t.Year() != year || t.Month() != month || t.Day() != day || year < 1 || year > 9999 {
+		input := fmt.Sprintf("%04d-%02d-%02d", year, int(month), day)
+		return Date{}, dateError(input, "range", fmt.Sprintf("Date %s does not exist.", input))
+	}
+	return Date{year: year, month: month, day: day}, nil
+}
+
+func MustNewDate(year int, month time.Month, day int) Date {
+	d, err := NewDate(year, month, day)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DateOf retorna a data civil de t no fuso do próprio t.
+func DateOf(t time.Time) Date {
+	return Date{year: t.Year(), month: t.Month(), day: t.Day()}
+}
+
+// ParseDate aceita "2026-10-16" (ISO 8601) e "16/10/2026" (dd/mm/aaaa).
+func ParseDate(value string) (Date, error) {
+	s := strings.TrimSpace(value)
+	layout := DateLayoutISO
+	if strings.Contains(s, "/") {
+		layout = DateLayoutBR
+	}
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		message := fmt.Sprintf("Invalid date '%s'. Expected YYYY-MM-DD or DD/MM/YYYY.", value)
+		return Date{}, msg.NewValidationError(err,
+			msg.ValidationContext{Field: "date", Input: value, Constraint: "format"}.ContextMap(),
+			message,
+		)
+	}
+	return NewDate(t.Year(), t.Month(), t.Day())
+}
+
+func (d Date) Year() int             { return d.year }
+func (d Date) Month() time.Month     { return d.month }
+func (d Date) Day() int              { return d.day }
+func (d Date) IsZero() bool          { return d == Date{} }
+func (d Date) Weekday() time.Weekday { return d.time().Weekday() }
+
+func (d Date) time() time.Time {
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, time.UTC)
+}
+
+// In retorna a meia-noite da data no fuso informado.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, loc)
+}
+
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.time().Format(DateLayoutISO)
+}
+
+// Format retorna "16/10/2026" (pt-BR) ou "10/16/2026" (en-US).
+func (d Date) Format(locale Locale) (string, error) {
+	if d.IsZero() && locale.IsValid() {
+		return "", nil
+	}
+	switch locale {
+	case LocalePtBR:
+		return d.time().Format(DateLayoutBR), nil
+	case LocaleEnUS:
+		return d.time().Format("01/02/2006"), nil
+	default:
+		message := fmt.Sprintf("Locale '%s' is not supported for date formatting.", locale)
+		return "", msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "date", Input: string(locale), Constraint: "locale", Limit: []Locale{LocalePtBR, LocaleEnUS}}.ContextMap(),
+			message,
+		).WithContext(msg.KeyLocale, string(locale))
+	}
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func (d Date) DaysInMonth() int {
+	return daysIn(d.year, d.month)
+}
+
+func (d Date) FirstDayOfMonth() Date {
+	return Date{year: d.year, month: d.month, day: 1}
+}
+
+func (d Date) LastDayOfMonth() Date {
+	return Date{year: d.year, month: d.month, day: d.DaysInMonth()}
+}
+
+func (d Date) IsLastDayOfMonth() bool {
+	return d.day == d.DaysInMonth()
+}
+
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.time().AddDate(0, 0, n))
+}
+
+// AddMonths soma meses limitando ao último dia do mês (MonthEndClamp).
+func (d Date) AddMonths(n int) Date {
+	shifted, _ := d.AddMonthsWithPolicy(n, MonthEndClamp)
+	return shifted
+}
+
+func (d Date) AddMonthsWithPolicy(n int, policy MonthEndPolicy) (Date, error) {
+	switch policy {
+	case MonthEndClamp:
+		first := d.time().AddDate(0, 0, 1-d.day).AddDate(0, n, 0)
+		day := min(d.day, daysIn(first.Year(), first.Month()))
+		return Date{year: first.Year(), month: first.Month(), day: day}, nil
+	case MonthEndRollover:
+		return DateOf(d.time().AddDate(0, n, 0)), nil
+	default:
+		return Date{}, monthEndPolicyError(policy)
+	}
+}
+
+// AddYears soma anos; 29/02 vira 28/02 em anos não bissextos.
+func (d Date) AddYears(n int) Date {
+	return d.AddMonths(12 * n)
+}
+
+func monthEndPolicyError(policy MonthEndPolicy) error {
+	message := fmt.Sprintf("Month-end policy '%s' is not valid.", policy)
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "month_end_policy", Input: string(policy), Constraint: "enum"}.ContextMap(),
+		message,
+	)
+}
+
+// DaysUntil retorna o número de dias de d até other; negativo se other for anterior.
+func (d Date) DaysUntil(other Date) int {
+	return int(other.time().Sub(d.time()).Hours() / 24)
+}
+
+func (d Date) Compare(other Date) int {
+	return d.time().Compare(other.time())
+}
+
+func (d Date) Before(other Date) bool { return d.Compare(other) < 0 }
+func (d Date) After(other Date) bool  { return d.Compare(other) > 0 }
+func (d Date) Equal(other Date) bool  { return d == other }
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = Date{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		message := fmt.Sprintf("Date must be a JSON string in YYYY-MM-DD format or 'null'; received '%s'.", string(data))
+		return msg.NewValidationError(err,
+			msg.ConversionContext{TargetType: "Date", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
+			message,
+		)
+	}
+	parsed, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value grava a data como texto ISO, aceito por colunas DATE em PostgreSQL, MySQL e SQLite
+// sem conversão de fuso pelo driver.
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.String(), nil
+}
+
+// Scan aceita time.Time (pgx, pq, MySQL com parseTime) e texto ISO, inclusive
+// timestamps como "2026-10-16 00:00:00" ou "2026-10-16T00:00:00Z" (SQLite).
+func (d *Date) Scan(src interface{}) error {
+	var raw string
+	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = DateOf(v)
+		return nil
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		message := fmt.Sprintf("Incompatible type (%T) for Date. Expected time.Time, string or []byte.", src)
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "Date", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
+	}
+	if len(raw) > len(DateLayoutISO) && (raw[len(DateLayoutISO)] == 'T' || raw[len(DateLayoutISO)] == ' ') {
+		raw = raw[:len(DateLayoutISO)]
+	}
+	parsed, err := ParseDate(raw)
+	if err != nil {
+		if msgErr, ok := err.(*msg.MessageError); ok {
+			msgErr.WithContext(msg.KeySource, msg.SourceDB)
+			return msgErr
+		}
+		return err
+	}
+	*d = parsed
+	return nil
+}
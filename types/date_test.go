@@ -0,0 +1,171 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestNewDate(t *testing.T) {
+	d, err := types.NewDate(2028, time.February, 29)
+	require.NoError(t, err)
+	assert.Equal(t, "2028-02-29", d.String())
+
+	_, err = types.NewDate(2026, time.February, 29)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "range", msgErr.Context[msg.KeyConstraint])
+	assert.Equal(t, "2026-02-29", msgErr.Context[msg.KeyInput])
+}
+
+func TestParseDate(t *testing.T) {
+	expected := types.MustNewDate(2026, time.October, 16)
+	for _, input := range []string{"2026-10-16", "16/10/2026", " 2026-10-16 "} {
+		d, err := types.ParseDate(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, d)
+	}
+
+	for _, input := range []string{"", "2026/10/16", "10-16-2026", "31/02/2026", "16/10/26"} {
+		_, err := types.ParseDate(input)
+		msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+		assert.Equal(t, "format", msgErr.Context[msg.KeyConstraint], input)
+	}
+
+	for _, input := range []string{"0000-01-01", "01/01/0000"} {
+		_, err := types.ParseDate(input)
+		msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+		assert.Equal(t, "range", msgErr.Context[msg.KeyConstraint], input)
+	}
+}
+
+func TestDateOf_UsesTimeLocation(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+	instant := time.Date(2026, time.October, 17, 1, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, types.MustNewDate(2026, time.October, 17), types.DateOf(instant))
+	assert.Equal(t, types.MustNewDate(2026, time.October, 16), types.DateOf(instant.In(saoPaulo)))
+}
+
+func TestDate_Format(t *testing.T) {
+	d := types.MustNewDate(2026, time.October, 6)
+	s, err := d.Format(types.LocalePtBR)
+	require.NoError(t, err)
+	assert.Equal(t, "06/10/2026", s)
+
+	s, err = d.Format(types.LocaleEnUS)
+	require.NoError(t, err)
+	assert.Equal(t, "10/06/2026", s)
+
+	_, err = d.Format(types.Locale("fr-FR"))
+	requireMsgErrorCode(t, err, msg.CodeInvalid)
+}
+
+func TestDate_Arithmetic(t *testing.T) {
+	jan31 := types.MustNewDate(2026, time.January, 31)
+
+	assert.Equal(t, types.MustNewDate(2026, time.February, 1), jan31.AddDays(1))
+	assert.Equal(t, types.MustNewDate(2025, time.December, 31), jan31.AddDays(-31))
+	assert.Equal(t, types.MustNewDate(2026, time.February, 28), jan31.AddMonths(1))
+	assert.Equal(t, types.MustNewDate(2028, time.February, 29), types.MustNewDate(2027, time.December, 31).AddMonths(2))
+	assert.Equal(t, types.MustNewDate(2025, time.November, 30), jan31.AddMonths(-2))
+
+	rolled, err := jan31.AddMonthsWithPolicy(1, types.MonthEndRollover)
+	require.NoError(t, err)
+	assert.Equal(t, types.MustNewDate(2026, time.March, 3), rolled)
+
+	_, err = jan31.AddMonthsWithPolicy(1, types.MonthEndPolicy("last"))
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "month_end_policy", msgErr.Context[msg.KeyField])
+
+	leap := types.MustNewDate(2028, time.February, 29)
+	assert.Equal(t, types.MustNewDate(2029, time.February, 28), leap.AddYears(1))
+	assert.Equal(t, types.MustNewDate(2032, time.February, 29), leap.AddYears(4))
+}
+
+func TestDate_MonthHelpers(t *testing.T) {
+	d := types.MustNewDate(2028, time.February, 10)
+	assert.Equal(t, 29, d.DaysInMonth())
+	assert.Equal(t, types.MustNewDate(2028, time.February, 1), d.FirstDayOfMonth())
+	assert.Equal(t, types.MustNewDate(2028, time.February, 29), d.LastDayOfMonth())
+	assert.False(t, d.IsLastDayOfMonth())
+	assert.True(t, d.LastDayOfMonth().IsLastDayOfMonth())
+	assert.Equal(t, time.Thursday, d.Weekday())
+}
+
+func TestDate_Compare(t *testing.T) {
+	a := types.MustNewDate(2026, time.October, 16)
+	b := types.MustNewDate(2026, time.December, 25)
+
+	assert.True(t, a.Before(b))
+	assert.True(t, b.After(a))
+	assert.True(t, a.Equal(types.MustNewDate(2026, time.October, 16)))
+	assert.Equal(t, 70, a.DaysUntil(b))
+	assert.Equal(t, -70, b.DaysUntil(a))
+	assert.Equal(t, time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC), a.In(time.UTC))
+}
+
+func TestDate_JSON(t *testing.T) {
+	type payload struct {
+		Birthday types.Date `json:"birthday"`
+	}
+	data, err := json.Marshal(payload{Birthday: types.MustNewDate(2026, time.October, 16)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"birthday":"2026-10-16"}`, string(data))
+
+	var decoded payload
+	require.NoError(t, json.Unmarshal([]byte(`{"birthday":"16/10/2026"}`), &decoded))
+	assert.Equal(t, types.MustNewDate(2026, time.October, 16), decoded.Birthday)
+
+	data, err = json.Marshal(payload{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"birthday":null}`, string(data))
+
+	err = json.Unmarshal([]byte(`{"birthday":20261016}`), &decoded)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "Date", msgErr.Context[msg.KeyTargetType])
+}
+
+func TestDate_SQL(t *testing.T) {
+	d := types.MustNewDate(2026, time.October, 16)
+	value, err := d.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-16", value)
+
+	value, err = types.Date{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	testCases := []struct {
+		name string
+		src  interface{}
+	}{
+		{"time", time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)},
+		{"string", "2026-10-16"},
+		{"bytes", []byte("2026-10-16")},
+		{"sqlite timestamp", "2026-10-16 00:00:00"},
+		{"rfc3339", "2026-10-16T00:00:00Z"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var scanned types.Date
+			require.NoError(t, scanned.Scan(tc.src))
+			assert.Equal(t, d, scanned)
+		})
+	}
+
+	var scanned types.Date
+	require.NoError(t, scanned.Scan(nil))
+	assert.True(t, scanned.IsZero())
+
+	msgErr := requireMsgErrorCode(t, scanned.Scan(int64(20261016)), msg.CodeInvalid)
+	assert.Equal(t, "int64", msgErr.Context[msg.KeyReceivedType])
+
+	msgErr = requireMsgErrorCode(t, scanned.Scan("16-10-2026"), msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+}
@@ -12,8 +12,8 @@ var ErrInvalidDay = fmt.Errorf("day must be between 1 and 31")
 type Day int
 
 func NewDay(value int) (Day, error) {
-	if value < 1 || value > 31 {
-		return 0, ErrInvalidDay
+	if err := Day(value).validate(); err != nil {
+		return 0, err
 	}
 	return Day(value), nil
 }
@@ -22,36 +22,30 @@ func (d Day) Int() int {
 	return int(d)
 }
 
-// HasPassed verifica se este dia já passou no mês corrente.
+// HasPassed verifica se este dia já passou no mês corrente; dias inexistentes
+// no mês (ex.: 31 em abril) são limitados ao último dia.
 func (d Day) HasPassed(today time.Time) bool {
-	return d.Int() < today.Day()
+	return min(d.Int(), daysIn(today.Year(), today.Month())) < today.Day()
 }
 
-// DaysUntil calcula quantos dias faltam para a próxima ocorrência deste dia.
+// DaysUntil calcula quantos dias faltam para a próxima ocorrência deste dia,
+// usando MonthEndClamp. Retorna 0 para dias fora de 1–31.
 func (d Day) DaysUntil(today time.Time) int {
-	day := d.Int()
-	todayDay := today.Day()
-
-	if day >= todayDay {
-		return day - todayDay
+	due, err := d.NextDueDate(today, MonthEndClamp)
+	if err != nil {
+		return 0
 	}
-
-	daysInMonth := time.Date(today.Year(), today.Month()+1, 0, 0, 0, 0, 0, today.Location()).Day()
-	return (daysInMonth - todayDay) + day
+	return DateOf(today).DaysUntil(due)
 }
 
-// DaysOverdue calcula quantos dias se passaram desde a última ocorrência deste dia.
+// DaysOverdue calcula quantos dias se passaram desde a última ocorrência deste dia,
+// usando MonthEndClamp. Retorna 0 para dias fora de 1–31.
 func (d Day) DaysOverdue(today time.Time) int {
-	day := d.Int()
-	todayDay := today.Day()
-
-	if day <= todayDay {
-		return todayDay - day
+	due, err := d.PreviousDueDate(today, MonthEndClamp)
+	if err != nil {
+		return 0
 	}
-
-	prevMonth := today.AddDate(0, -1, 0)
-	daysInPrevMonth := time.Date(prevMonth.Year(), prevMonth.Month()+1, 0, 0, 0, 0, 0, today.Location()).Day()
-	return (daysInPrevMonth - day) + todayDay
+	return due.DaysUntil(DateOf(today))
 }
 
 func (d Day) MarshalJSON() ([]byte, error) {
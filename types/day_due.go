@@ -0,0 +1,89 @@
+package types
+
+import (
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+// dueDateSearchMonths cobre a maior sequência de meses pulados (dia 31 em jul/ago e dez/jan)
+// e o transbordo de MonthEndRollover para o mês seguinte.
+const dueDateSearchMonths = 3
+
+func (d Day) validate() error {
+	if d < 1 || d > 31 {
+		return msg.NewValidationError(ErrInvalidDay,
+			msg.ValidationContext{Field: "day", Input: d.Int(), Constraint: "range", Limit: 31}.ContextMap(),
+			ErrInvalidDay.Error(),
+		)
+	}
+	return nil
+}
+
+// ResolveIn retorna o vencimento deste dia na competência segundo a política.
+// Com MonthEndSkip, ok é false quando o dia não existe no mês.
+func (d Day) ResolveIn(ym YearMonth, policy MonthEndPolicy) (date Date, ok bool, err error) {
+	if err := d.validate(); err != nil {
+		return Date{}, false, err
+	}
+	days := ym.Days()
+	if d.Int() <= days {
+		return Date{year: ym.year, month: ym.month, day: d.Int()}, true, nil
+	}
+	switch policy {
+	case MonthEndClamp:
+		return ym.LastDay(), true, nil
+	case MonthEndRollover:
+		return ym.LastDay().AddDays(d.Int() - days), true, nil
+	case MonthEndSkip:
+		return Date{}, false, nil
+	default:
+		return Date{}, false, monthEndPolicyError(policy)
+	}
+}
+
+// dueDatesAround lista os vencimentos resolvidos nos meses próximos à data de referência, em ordem.
+func (d Day) dueDatesAround(ref Date, policy MonthEndPolicy) ([]Date, error) {
+	base := ref.YearMonth()
+	dates := make([]Date, 0, 2*dueDateSearchMonths+1)
+	for offset := -dueDateSearchMonths; offset <= dueDateSearchMonths; offset++ {
+		date, ok, err := d.ResolveIn(base.AddMonths(offset), policy)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			dates = append(dates, date)
+		}
+	}
+	return dates, nil
+}
+
+// NextDueDate retorna o primeiro vencimento na data civil de ref (no fuso de ref) ou depois dela.
+func (d Day) NextDueDate(ref time.Time, policy MonthEndPolicy) (Date, error) {
+	today := DateOf(ref)
+	dates, err := d.dueDatesAround(today, policy)
+	if err != nil {
+		return Date{}, err
+	}
+	for _, date := range dates {
+		if !date.Before(today) {
+			return date, nil
+		}
+	}
+	return Date{}, nil
+}
+
+// PreviousDueDate retorna o último vencimento na data civil de ref (no fuso de ref) ou antes dela.
+func (d Day) PreviousDueDate(ref time.Time, policy MonthEndPolicy) (Date, error) {
+	today := DateOf(ref)
+	dates, err := d.dueDatesAround(today, policy)
+	if err != nil {
+		return Date{}, err
+	}
+	for i := len(dates) - 1; i >= 0; i-- {
+		if !dates[i].After(today) {
+			return dates[i], nil
+		}
+	}
+	return Date{}, nil
+}
@@ -0,0 +1,134 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestDay_ResolveIn(t *testing.T) {
+	feb2026 := types.MustNewYearMonth(2026, time.February)
+	feb2028 := types.MustNewYearMonth(2028, time.February)
+
+	testCases := []struct {
+		name     string
+		day      types.Day
+		month    types.YearMonth
+		policy   types.MonthEndPolicy
+		expected types.Date
+		ok       bool
+	}{
+		{"existing day", 10, feb2026, types.MonthEndSkip, types.MustNewDate(2026, time.February, 10), true},
+		{"clamp 31 in february", 31, feb2026, types.MonthEndClamp, types.MustNewDate(2026, time.February, 28), true},
+		{"rollover 31 in february", 31, feb2026, types.MonthEndRollover, types.MustNewDate(2026, time.March, 3), true},
+		{"skip 31 in february", 31, feb2026, types.MonthEndSkip, types.Date{}, false},
+		{"29 in leap february", 29, feb2028, types.MonthEndSkip, types.MustNewDate(2028, time.February, 29), true},
+		{"29 in common february rollover", 29, feb2026, types.MonthEndRollover, types.MustNewDate(2026, time.March, 1), true},
+		{"30 in leap february rollover", 30, feb2028, types.MonthEndRollover, types.MustNewDate(2028, time.March, 1), true},
+		{"31 in april clamp", 31, types.MustNewYearMonth(2026, time.April), types.MonthEndClamp, types.MustNewDate(2026, time.April, 30), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			date, ok, err := tc.day.ResolveIn(tc.month, tc.policy)
+			require.NoError(t, err)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, date)
+		})
+	}
+}
+
+func TestDay_ResolveIn_Errors(t *testing.T) {
+	_, _, err := types.Day(32).ResolveIn(types.MustNewYearMonth(2026, time.March), types.MonthEndClamp)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.ErrorIs(t, err, types.ErrInvalidDay)
+	assert.Equal(t, "day", msgErr.Context[msg.KeyField])
+
+	_, _, err = types.Day(31).ResolveIn(types.MustNewYearMonth(2026, time.February), types.MonthEndPolicy("last"))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "month_end_policy", msgErr.Context[msg.KeyField])
+}
+
+func TestDay_NextAndPreviousDueDate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		day      types.Day
+		ref      time.Time
+		policy   types.MonthEndPolicy
+		next     types.Date
+		previous types.Date
+	}{
+		{
+			"clamp in february", 31, time.Date(2026, time.February, 10, 12, 0, 0, 0, time.UTC), types.MonthEndClamp,
+			types.MustNewDate(2026, time.February, 28), types.MustNewDate(2026, time.January, 31),
+		},
+		{
+			"rollover from february lands in march", 31, time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC), types.MonthEndRollover,
+			types.MustNewDate(2026, time.March, 3), types.MustNewDate(2026, time.January, 31),
+		},
+		{
+			"skip february", 31, time.Date(2026, time.February, 10, 12, 0, 0, 0, time.UTC), types.MonthEndSkip,
+			types.MustNewDate(2026, time.March, 31), types.MustNewDate(2026, time.January, 31),
+		},
+		{
+			"skip april", 31, time.Date(2026, time.April, 15, 12, 0, 0, 0, time.UTC), types.MonthEndSkip,
+			types.MustNewDate(2026, time.May, 31), types.MustNewDate(2026, time.March, 31),
+		},
+		{
+			"due today is both next and previous", 15, time.Date(2026, time.October, 15, 23, 59, 0, 0, time.UTC), types.MonthEndClamp,
+			types.MustNewDate(2026, time.October, 15), types.MustNewDate(2026, time.October, 15),
+		},
+		{
+			"leap year keeps the 29th", 29, time.Date(2028, time.February, 20, 0, 0, 0, 0, time.UTC), types.MonthEndSkip,
+			types.MustNewDate(2028, time.February, 29), types.MustNewDate(2028, time.January, 29),
+		},
+		{
+			"common year skips the 29th", 29, time.Date(2026, time.February, 20, 0, 0, 0, 0, time.UTC), types.MonthEndSkip,
+			types.MustNewDate(2026, time.March, 29), types.MustNewDate(2026, time.January, 29),
+		},
+		{
+			"year boundary", 5, time.Date(2026, time.December, 20, 0, 0, 0, 0, time.UTC), types.MonthEndClamp,
+			types.MustNewDate(2027, time.January, 5), types.MustNewDate(2026, time.December, 5),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next, err := tc.day.NextDueDate(tc.ref, tc.policy)
+			require.NoError(t, err)
+			assert.Equal(t, tc.next, next, "next")
+
+			previous, err := tc.day.PreviousDueDate(tc.ref, tc.policy)
+			require.NoError(t, err)
+			assert.Equal(t, tc.previous, previous, "previous")
+		})
+	}
+}
+
+func TestDay_DueDateUsesReferenceTimeZone(t *testing.T) {
+	brt := time.FixedZone("BRT", -3*60*60)
+	instant := time.Date(2026, time.March, 1, 2, 0, 0, 0, time.UTC)
+
+	next, err := types.Day(28).NextDueDate(instant, types.MonthEndClamp)
+	require.NoError(t, err)
+	assert.Equal(t, types.MustNewDate(2026, time.March, 28), next)
+
+	next, err = types.Day(28).NextDueDate(instant.In(brt), types.MonthEndClamp)
+	require.NoError(t, err)
+	assert.Equal(t, types.MustNewDate(2026, time.February, 28), next, "it is still February 28 in São Paulo")
+
+	assert.Equal(t, 1, types.Day(28).DaysOverdue(instant))
+	assert.Equal(t, 0, types.Day(28).DaysOverdue(instant.In(brt)))
+}
+
+func TestDay_DaysUntilAndOverdueAtMonthEnd(t *testing.T) {
+	assert.Equal(t, 13, types.Day(31).DaysUntil(time.Date(2026, time.February, 15, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1, types.Day(31).DaysOverdue(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1, types.Day(30).DaysOverdue(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, types.Day(31).HasPassed(time.Date(2026, time.April, 30, 12, 0, 0, 0, time.UTC)))
+	assert.False(t, types.Day(31).HasPassed(time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, types.Day(29).HasPassed(time.Date(2026, time.March, 30, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,315 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+const (
+	// MaxDecimalScale acompanha o limite de casas decimais do NUMERIC do PostgreSQL.
+	MaxDecimalScale = 16383
+	// MaxDecimalExponent limita a notação científica (a faixa do float64), evitando que
+	// uma entrada curta como "1e16000" aloque inteiros enormes.
+	MaxDecimalExponent = 308
+)
+
+var ErrDivisionByZero = fmt.Errorf("division by zero")
+
+// Decimal é um número decimal exato de precisão arbitrária: unscaled × 10^-scale.
+// O valor zero é o número 0 com escala 0.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+func decimalError(input any, constraint, message string, limit any) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "decimal", Input: input, Constraint: constraint, Limit: limit}.ContextMap(),
+		message,
+	)
+}
+
+func decimalScaleError(scale int) error {
+	message := fmt.Sprintf("Decimal scale must be between 0 and %d, got %d.", MaxDecimalScale, scale)
+	return decimalError(scale, "scale", message, MaxDecimalScale)
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// NewDecimal interpreta textos como "1234.5600", "-0.01" ou "1.5e3"; a escala
+// é a quantidade de dígitos informados após o ponto.
+func NewDecimal(value string) (Decimal, error) {
+	s := strings.TrimSpace(value)
+	formatErr := func() error {
+		return decimalError(value, "format", fmt.Sprintf("Invalid decimal format: '%s'.", value), nil)
+	}
+
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return Decimal{}, formatErr()
+		}
+		if e > MaxDecimalExponent || e < -MaxDecimalExponent {
+			message := fmt.Sprintf("Decimal exponent must be between -%d and %d, got %d.", MaxDecimalExponent, MaxDecimalExponent, e)
+			return Decimal{}, decimalError(value, "exponent", message, MaxDecimalExponent)
+		}
+		mantissa, exponent = s[:i], e
+	}
+
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") || strings.HasPrefix(mantissa, "+") {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	if intPart+fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return Decimal{}, formatErr()
+	}
+
+	unscaled, ok := new(big.Int).SetString(sign+intPart+fracPart, 10)
+	if !ok {
+		return Decimal{}, formatErr()
+	}
+	scale := len(fracPart) - exponent
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
+	if scale > MaxDecimalScale {
+		return Decimal{}, decimalScaleError(scale)
+	}
+	return Decimal{unscaled: unscaled, scale: scale}, nil
+}
+
+func MustNewDecimal(value string) Decimal {
+	d, err := NewDecimal(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func NewDecimalFromInt(value int64) Decimal {
+	return Decimal{unscaled: big.NewInt(value), scale: 0}
+}
+
+// NewDecimalWithScale cria unscaled × 10^-scale, ex.: (12345, 2) é 123.45.
+func NewDecimalWithScale(unscaled int64, scale int) (Decimal, error) {
+	if scale < 0 || scale > MaxDecimalScale {
+		return Decimal{}, decimalScaleError(scale)
+	}
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}, nil
+}
+
+func (d Decimal) coefficient() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// Unscaled retorna uma cópia do coeficiente inteiro.
+func (d Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(d.coefficient())
+}
+
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+func (d Decimal) Sign() int {
+	return d.coefficient().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.Unscaled(), pow10(d.scale))
+}
+
+// String retorna a notação sem expoente preservando a escala, ex.: "1.50".
+func (d Decimal) String() string {
+	c := d.coefficient()
+	digits := new(big.Int).Abs(c).String()
+	if d.scale > 0 {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+	if c.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+func (d Decimal) rescaled(scale int) *big.Int {
+	c := d.Unscaled()
+	if scale > d.scale {
+		c.Mul(c, pow10(scale-d.scale))
+	}
+	return c
+}
+
+func alignDecimals(a, b Decimal) (*big.Int, *big.Int, int) {
+	scale := max(a.scale, b.scale)
+	return a.rescaled(scale), b.rescaled(scale), scale
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.coefficient()), scale: d.scale}
+}
+
+func (d Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(d.coefficient()), scale: d.scale}
+}
+
+// Add é exata; a escala do resultado é a maior entre as parcelas.
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b, scale := alignDecimals(d, other)
+	return Decimal{unscaled: a.Add(a, b), scale: scale}
+}
+
+func (d Decimal) Sub(other Decimal) Decimal {
+	a, b, scale := alignDecimals(d, other)
+	return Decimal{unscaled: a.Sub(a, b), scale: scale}
+}
+
+// Mul é exata; a escala do resultado é a soma das escalas. Use Round para reduzi-la.
+// Retorna erro quando a soma ultrapassa MaxDecimalScale.
+func (d Decimal) Mul(other Decimal) (Decimal, error) {
+	scale := d.scale + other.scale
+	if scale > MaxDecimalScale {
+		return Decimal{}, decimalScaleError(scale)
+	}
+	return Decimal{unscaled: new(big.Int).Mul(d.coefficient(), other.coefficient()), scale: scale}, nil
+}
+
+func roundRatToDecimal(r *big.Rat, scale int, mode RoundingMode) (Decimal, error) {
+	if scale < 0 || scale > MaxDecimalScale {
+		return Decimal{}, decimalScaleError(scale)
+	}
+	if !mode.IsValid() {
+		return Decimal{}, roundingModeError(mode)
+	}
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(scale)))
+	return Decimal{unscaled: roundRat(scaled, mode), scale: scale}, nil
+}
+
+// Div divide e arredonda o quociente para a escala informada.
+func (d Decimal) Div(other Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if other.IsZero() {
+		message := fmt.Sprintf("Cannot divide decimal %s by zero.", d)
+		return Decimal{}, msg.NewDomainError(ErrDivisionByZero, message,
+			msg.ValidationContext{Field: "decimal", Input: d.String(), Constraint: "division_by_zero"}.ContextMap(),
+		)
+	}
+	return roundRatToDecimal(new(big.Rat).Quo(d.Rat(), other.Rat()), scale, mode)
+}
+
+// Round ajusta a escala, arredondando quando ela diminui e completando com zeros quando aumenta.
+func (d Decimal) Round(scale int, mode RoundingMode) (Decimal, error) {
+	return roundRatToDecimal(d.Rat(), scale, mode)
+}
+
+// Cmp compara numericamente, ignorando a escala: 1.5 e 1.50 são iguais.
+func (d Decimal) Cmp(other Decimal) int {
+	a, b, _ := alignDecimals(d, other)
+	return a.Cmp(b)
+}
+
+func (d Decimal) Equals(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+func (d Decimal) GreaterThan(other Decimal) bool {
+	return d.Cmp(other) > 0
+}
+
+func (d Decimal) LessThan(other Decimal) bool {
+	return d.Cmp(other) < 0
+}
+
+// MarshalJSON serializa como string para preservar todos os dígitos em qualquer cliente.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON aceita string ("1.50") ou número literal (1.50) sem passar por float64.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	raw := strings.TrimSpace(string(data))
+	if raw == "null" || raw == "" {
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "Decimal", Source: msg.SourceJSON, Input: raw}.ContextMap(),
+			"Decimal must be a JSON string or number.",
+		)
+	}
+	if strings.HasPrefix(raw, `"`) {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return msg.NewValidationError(err,
+				msg.ConversionContext{TargetType: "Decimal", Source: msg.SourceJSON, Input: raw}.ContextMap(),
+				"Decimal must be a JSON string or number.",
+			)
+		}
+		raw = s
+	}
+	parsed, err := NewDecimal(raw)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value grava como texto, formato aceito por colunas NUMERIC/DECIMAL dos drivers SQL.
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan lê NUMERIC como texto ou inteiro; float64 é aceito pela sua representação mais curta.
+func (d *Decimal) Scan(src interface{}) error {
+	var raw string
+	switch v := src.(type) {
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	case int64:
+		*d = NewDecimalFromInt(v)
+		return nil
+	case float64:
+		raw = strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "Decimal", Source: msg.SourceDB}.ContextMap(),
+			"Scanned nil value for non-nullable Decimal.",
+		)
+	default:
+		message := fmt.Sprintf("Incompatible type (%T) for Decimal. Expected string, []byte, int64 or float64.", src)
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "Decimal", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
+	}
+	parsed, err := NewDecimal(raw)
+	if err != nil {
+		if msgErr, ok := err.(*msg.MessageError); ok {
+			msgErr.WithContext(msg.KeySource, msg.SourceDB)
+			return msgErr
+		}
+		return err
+	}
+	*d = parsed
+	return nil
+}
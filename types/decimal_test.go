@@ -0,0 +1,213 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestNewDecimal(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+		scale    int
+	}{
+		{"1234.5600", "1234.5600", 4},
+		{"-0.01", "-0.01", 2},
+		{"+7", "7", 0},
+		{".5", "0.5", 1},
+		{" 42. ", "42", 0},
+		{"1.5e3", "1500", 0},
+		{"1.5E-3", "0.0015", 4},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789", 9},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			d, err := types.NewDecimal(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, d.String())
+			assert.Equal(t, tc.scale, d.Scale())
+		})
+	}
+}
+
+func TestNewDecimal_Invalid(t *testing.T) {
+	for _, input := range []string{"", ".", "-", "1,5", "1.2.3", "abc", "1e", "1e99999"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := types.NewDecimal(input)
+			msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+			assert.Equal(t, "decimal", msgErr.Context[msg.KeyField])
+		})
+	}
+}
+
+func TestNewDecimal_ExponentLimit(t *testing.T) {
+	d, err := types.NewDecimal("1e308")
+	require.NoError(t, err)
+	assert.Equal(t, 0, d.Scale())
+
+	for _, input := range []string{"1e309", "1e-16000", "1e16000"} {
+		_, err := types.NewDecimal(input)
+		msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+		assert.Equal(t, "exponent", msgErr.Context[msg.KeyConstraint])
+		assert.Equal(t, types.MaxDecimalExponent, msgErr.Context[msg.KeyLimit])
+	}
+}
+
+func TestDecimal_MulScaleLimit(t *testing.T) {
+	fine, err := types.NewDecimalWithScale(1, types.MaxDecimalScale)
+	require.NoError(t, err)
+
+	_, err = fine.Mul(types.MustNewDecimal("0.1"))
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "scale", msgErr.Context[msg.KeyConstraint])
+
+	product, err := fine.Mul(types.MustNewDecimal("10"))
+	require.NoError(t, err)
+	assert.Equal(t, types.MaxDecimalScale, product.Scale())
+}
+
+func TestDecimal_ZeroValue(t *testing.T) {
+	var d types.Decimal
+	assert.True(t, d.IsZero())
+	assert.Equal(t, "0", d.String())
+	assert.Equal(t, "1.5", d.Add(types.MustNewDecimal("1.5")).String())
+}
+
+func TestNewDecimalWithScale(t *testing.T) {
+	d, err := types.NewDecimalWithScale(12345, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "123.45", d.String())
+
+	_, err = types.NewDecimalWithScale(1, -1)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "scale", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a := types.MustNewDecimal("10.25")
+	b := types.MustNewDecimal("0.125")
+
+	assert.Equal(t, "10.375", a.Add(b).String())
+	assert.Equal(t, "10.125", a.Sub(b).String())
+	product, err := a.Mul(b)
+	require.NoError(t, err)
+	assert.Equal(t, "1.28125", product.String())
+	assert.Equal(t, "-10.25", a.Neg().String())
+	assert.Equal(t, "10.25", a.Neg().Abs().String())
+	assert.Equal(t, "10.25", a.String(), "operations must not mutate the receiver")
+
+	q, err := types.MustNewDecimal("1").Div(types.MustNewDecimal("3"), 4, types.RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, "0.3333", q.String())
+}
+
+func TestDecimal_DivisionByZero(t *testing.T) {
+	_, err := types.MustNewDecimal("10").Div(types.Decimal{}, 2, types.RoundHalfEven)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+	assert.True(t, errors.Is(err, types.ErrDivisionByZero))
+	assert.Equal(t, "division_by_zero", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestDecimal_Round(t *testing.T) {
+	testCases := []struct {
+		input    string
+		mode     types.RoundingMode
+		expected string
+	}{
+		{"2.345", types.RoundHalfEven, "2.34"},
+		{"2.355", types.RoundHalfEven, "2.36"},
+		{"2.345", types.RoundHalfUp, "2.35"},
+		{"-2.345", types.RoundHalfUp, "-2.35"},
+		{"2.349", types.RoundDown, "2.34"},
+		{"-2.349", types.RoundDown, "-2.34"},
+		{"2.341", types.RoundCeiling, "2.35"},
+		{"-2.349", types.RoundCeiling, "-2.34"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input+"/"+tc.mode.String(), func(t *testing.T) {
+			d, err := types.MustNewDecimal(tc.input).Round(2, tc.mode)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, d.String())
+		})
+	}
+
+	widened, err := types.MustNewDecimal("1.5").Round(3, types.RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, "1.500", widened.String())
+
+	_, err = types.MustNewDecimal("1.5").Round(1, types.RoundingMode("bankers"))
+	assert.True(t, errors.Is(err, types.ErrInvalidRoundingMode))
+}
+
+func TestDecimal_Compare(t *testing.T) {
+	a := types.MustNewDecimal("1.5")
+	b := types.MustNewDecimal("1.50")
+	c := types.MustNewDecimal("-2")
+
+	assert.True(t, a.Equals(b))
+	assert.Equal(t, 0, a.Cmp(b))
+	assert.True(t, a.GreaterThan(c))
+	assert.True(t, c.LessThan(a))
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	d := types.MustNewDecimal("1234567890.1234567890")
+	data, err := json.Marshal(d)
+	require.NoError(t, err)
+	assert.Equal(t, `"1234567890.1234567890"`, string(data))
+
+	var fromString types.Decimal
+	require.NoError(t, json.Unmarshal(data, &fromString))
+	assert.Equal(t, d.String(), fromString.String())
+
+	var fromNumber types.Decimal
+	require.NoError(t, json.Unmarshal([]byte(`0.10000000000000000001`), &fromNumber))
+	assert.Equal(t, "0.10000000000000000001", fromNumber.String())
+
+	var invalid types.Decimal
+	err = json.Unmarshal([]byte(`null`), &invalid)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "Decimal", msgErr.Context[msg.KeyTargetType])
+}
+
+func TestDecimal_SQL(t *testing.T) {
+	d := types.MustNewDecimal("99.90")
+	value, err := d.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "99.90", value)
+
+	testCases := []struct {
+		name     string
+		src      interface{}
+		expected string
+	}{
+		{"string", "99.90", "99.90"},
+		{"bytes", []byte("-0.001"), "-0.001"},
+		{"int64", int64(42), "42"},
+		{"float64", 0.1, "0.1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var scanned types.Decimal
+			require.NoError(t, scanned.Scan(tc.src))
+			assert.Equal(t, tc.expected, scanned.String())
+		})
+	}
+
+	var scanned types.Decimal
+	msgErr := requireMsgErrorCode(t, scanned.Scan(nil), msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+
+	msgErr = requireMsgErrorCode(t, scanned.Scan(true), msg.CodeInvalid)
+	assert.Equal(t, "bool", msgErr.Context[msg.KeyReceivedType])
+
+	msgErr = requireMsgErrorCode(t, scanned.Scan("abc"), msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+}
@@ -23,21 +23,21 @@ func validateEmail(emailStr string) (string, error) {
 
 	if normalizedEmail == "" {
 		return "", msg.NewValidationError(nil,
-			map[string]any{"input_email": emailStr},
+			msg.ValidationContext{Field: "email", Input: emailStr, Constraint: "required"}.ContextMap(),
 			"Email address cannot be empty.",
 		)
 	}
 	if len(normalizedEmail) > MaxEmailLength {
 		message := fmt.Sprintf("Email address (length %d) exceeds maximum length of %d characters.", len(normalizedEmail), MaxEmailLength)
 		return "", msg.NewValidationError(nil,
-			map[string]any{"length": len(normalizedEmail), "max_length": MaxEmailLength, "input_email": emailStr},
+			msg.ValidationContext{Field: "email", Input: emailStr, Constraint: "max_length", Limit: MaxEmailLength}.ContextMap(),
 			message,
-		)
+		).WithContext(msg.KeyLength, len(normalizedEmail))
 	}
 	if !emailRegexPattern.MatchString(normalizedEmail) {
 		message := fmt.Sprintf("Email address '%s' has an invalid format.", emailStr)
 		return "", msg.NewValidationError(nil,
-			map[string]any{"input_email": emailStr},
+			msg.ValidationContext{Field: "email", Input: emailStr, Constraint: "format"}.ContextMap(),
 			message,
 		)
 	}
@@ -52,6 +52,23 @@ func NewEmail(emailStr string) (Email, error) {
 	return Email(validatedEmail), nil
 }
 
+// NewEmailWithWarnings cria o Email e avisa quando a entrada precisou ser normalizada.
+func NewEmailWithWarnings(emailStr string) (Email, msg.Warnings, error) {
+	validatedEmail, err := validateEmail(emailStr)
+	if err != nil {
+		return "", nil, err
+	}
+	var warnings msg.Warnings
+	if validatedEmail != emailStr {
+		message := fmt.Sprintf("Email address '%s' was normalized to '%s'.", emailStr, validatedEmail)
+		warnings.Add(msg.NewNormalizedWarning(
+			msg.ValidationContext{Field: "email", Input: emailStr}.ContextMap(),
+			message,
+		).WithContext(msg.KeyNormalized, validatedEmail))
+	}
+	return Email(validatedEmail), warnings, nil
+}
+
 func MustNewEmail(emailStr string) Email {
 	email, err := NewEmail(emailStr)
 	if err != nil {
@@ -77,7 +94,7 @@ func (e *Email) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &s); err != nil {
 		message := fmt.Sprintf("Email must be a valid JSON string (received: %s).", string(data))
 		return msg.NewValidationError(err,
-			map[string]any{"input_json": string(data)},
+			msg.ConversionContext{TargetType: "Email", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
 			message,
 		)
 	}
@@ -110,7 +127,7 @@ func (e Email) Value() (driver.Value, error) {
 func (e *Email) Scan(src interface{}) error {
 	if src == nil {
 		return msg.NewValidationError(nil,
-			map[string]any{"target_type": "Email"},
+			msg.ConversionContext{TargetType: "Email", Source: msg.SourceDB}.ContextMap(),
 			"Scanned nil value for non-nullable Email type.",
 		)
 	}
@@ -123,7 +140,7 @@ func (e *Email) Scan(src interface{}) error {
 	default:
 		message := fmt.Sprintf("Incompatible type (%T) for Email. Expected string or []byte.", src)
 		return msg.NewValidationError(nil,
-			map[string]any{"received_type": fmt.Sprintf("%T", src)},
+			msg.ConversionContext{TargetType: "Email", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
 			message,
 		)
 	}
@@ -131,12 +148,12 @@ func (e *Email) Scan(src interface{}) error {
 	validatedEmail, err := validateEmail(emailStr)
 	if err != nil {
 		if originalMsgErr, ok := err.(*msg.MessageError); ok {
-			originalMsgErr.WithContext("scan_source_value", emailStr)
+			originalMsgErr.WithContext(msg.KeySource, msg.SourceDB)
 			return originalMsgErr
 		}
 		message := fmt.Sprintf("Failed to scan database value ('%s') to Email.", emailStr)
 		return msg.NewValidationError(err,
-			map[string]any{"scan_source_value": emailStr},
+			msg.ConversionContext{TargetType: "Email", Source: msg.SourceDB, Input: emailStr}.ContextMap(),
 			message,
 		)
 	}
@@ -215,3 +215,40 @@ func TestEmail_DatabaseEncoding(t *testing.T) {
 		})
 	}
 }
+
+func TestNewEmail_ErrorPayload(t *testing.T) {
+	_, err := types.NewEmail("invalid")
+	require.Error(t, err)
+
+	var msgErr *msg.MessageError
+	require.True(t, errors.As(err, &msgErr))
+
+	payload, decodeErr := msg.PayloadFrom[msg.ValidationContext](msgErr)
+	require.NoError(t, decodeErr)
+	assert.Equal(t, "email", payload.Field)
+	assert.Equal(t, "invalid", payload.Input)
+	assert.Equal(t, "format", payload.Constraint)
+}
+
+func TestNewEmailWithWarnings(t *testing.T) {
+	t.Run("warns when input is normalized", func(t *testing.T) {
+		email, warnings, err := types.NewEmailWithWarnings("  Ana@Example.COM ")
+		require.NoError(t, err)
+		assert.Equal(t, types.Email("ana@example.com"), email)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, msg.CodeNormalized, warnings[0].Code)
+		assert.Equal(t, "ana@example.com", warnings[0].Context[msg.KeyNormalized])
+	})
+
+	t.Run("no warnings for already normalized input", func(t *testing.T) {
+		_, warnings, err := types.NewEmailWithWarnings("ana@example.com")
+		require.NoError(t, err)
+		assert.True(t, warnings.IsEmpty())
+	})
+
+	t.Run("returns validation error", func(t *testing.T) {
+		_, warnings, err := types.NewEmailWithWarnings("invalid")
+		require.Error(t, err)
+		assert.Nil(t, warnings)
+	})
+}
@@ -0,0 +1,305 @@
+package types
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+var (
+	ErrRateNotFound = fmt.Errorf("exchange rate not found")
+	ErrStaleRate    = fmt.Errorf("exchange rate is stale")
+)
+
+// ExchangeRate informa quantas unidades de To equivalem a uma unidade de From.
+type ExchangeRate struct {
+	from   Currency
+	to     Currency
+	rate   *big.Rat
+	at     time.Time
+	source string
+}
+
+func currencyPair(from, to Currency) string {
+	return from.String() + "/" + to.String()
+}
+
+// NewExchangeRate cria uma cotação a partir de um texto exato ("5.4321" ou "1/3").
+func NewExchangeRate(from, to Currency, rate string, at time.Time, source string) (ExchangeRate, error) {
+	for _, c := range []Currency{from, to} {
+		if err := validateMoneyCurrency(c); err != nil {
+			return ExchangeRate{}, err
+		}
+	}
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || r.Sign() <= 0 {
+		message := fmt.Sprintf("Exchange rate '%s' for %s must be a positive number.", rate, currencyPair(from, to))
+		return ExchangeRate{}, msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "exchange_rate", Input: rate, Constraint: "positive"}.ContextMap(),
+			message,
+		)
+	}
+	return ExchangeRate{from: from, to: to, rate: r, at: at, source: source}, nil
+}
+
+func MustNewExchangeRate(from, to Currency, rate string, at time.Time, source string) ExchangeRate {
+	r, err := NewExchangeRate(from, to, rate, at, source)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (r ExchangeRate) From() Currency       { return r.from }
+func (r ExchangeRate) To() Currency         { return r.to }
+func (r ExchangeRate) Timestamp() time.Time { return r.at }
+func (r ExchangeRate) Source() string       { return r.source }
+
+// Rat retorna uma cópia da cotação exata.
+func (r ExchangeRate) Rat() *big.Rat {
+	if r.rate == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(r.rate)
+}
+
+// String retorna a cotação com até 10 casas decimais.
+func (r ExchangeRate) String() string {
+	return fmt.Sprintf("%s %s", currencyPair(r.from, r.to), strings.TrimRight(strings.TrimRight(r.Rat().FloatString(10), "0"), "."))
+}
+
+func (r ExchangeRate) Inverse() ExchangeRate {
+	return ExchangeRate{from: r.to, to: r.from, rate: new(big.Rat).Inv(r.Rat()), at: r.at, source: r.source}
+}
+
+type RateProvider interface {
+	Rate(ctx context.Context, from, to Currency) (ExchangeRate, error)
+}
+
+func rateNotFoundError(from, to Currency) error {
+	message := fmt.Sprintf("No exchange rate available for %s.", currencyPair(from, to))
+	return msg.NewMessageError(ErrRateNotFound, message, msg.CodeNotFound,
+		msg.ValidationContext{Field: "exchange_rate", Input: currencyPair(from, to), Constraint: "not_found"}.ContextMap(),
+	)
+}
+
+// StaticRateProvider mantém as cotações em memória; a inversa de um par é usada
+// quando apenas o par oposto foi registrado.
+type StaticRateProvider struct {
+	mu    sync.RWMutex
+	rates map[string]ExchangeRate
+}
+
+func NewStaticRateProvider(rates ...ExchangeRate) *StaticRateProvider {
+	p := &StaticRateProvider{rates: make(map[string]ExchangeRate)}
+	for _, r := range rates {
+		p.Set(r)
+	}
+	return p
+}
+
+func (p *StaticRateProvider) Set(rate ExchangeRate) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rates[currencyPair(rate.from, rate.to)] = rate
+}
+
+func (p *StaticRateProvider) Rate(ctx context.Context, from, to Currency) (ExchangeRate, error) {
+	if err := ctx.Err(); err != nil {
+		return ExchangeRate{}, err
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if r, ok := p.rates[currencyPair(from, to)]; ok {
+		return r, nil
+	}
+	if r, ok := p.rates[currencyPair(to, from)]; ok {
+		return r.Inverse(), nil
+	}
+	return ExchangeRate{}, rateNotFoundError(from, to)
+}
+
+func rateCSVError(err error, line int, input string, message string) error {
+	return msg.NewValidationError(err,
+		msg.ConversionContext{TargetType: "ExchangeRate", Source: msg.SourceCSV, Input: input}.ContextMap(),
+		message,
+	).WithContext(msg.KeyLine, line)
+}
+
+// LoadRatesCSV lê cotações no formato "from,to,rate,timestamp[,source]" com
+// cabeçalho na primeira linha e timestamp em RFC 3339.
+func LoadRatesCSV(r io.Reader) (*StaticRateProvider, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	provider := NewStaticRateProvider()
+	line := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, rateCSVError(err, line, "", fmt.Sprintf("Failed to read exchange rate CSV at line %d.", line))
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "from") {
+			continue
+		}
+		raw := strings.Join(record, ",")
+		if len(record) < 4 || len(record) > 5 {
+			return nil, rateCSVError(nil, line, raw, fmt.Sprintf("Exchange rate CSV line %d must have 4 or 5 columns.", line))
+		}
+		at, err := time.Parse(time.RFC3339, strings.TrimSpace(record[3]))
+		if err != nil {
+			return nil, rateCSVError(err, line, raw, fmt.Sprintf("Exchange rate CSV line %d has an invalid RFC 3339 timestamp.", line))
+		}
+		source := msg.SourceCSV
+		if len(record) == 5 {
+			source = strings.TrimSpace(record[4])
+		}
+		from, err := NewCurrency(record[0])
+		if err != nil {
+			return nil, rateCSVError(err, line, raw, fmt.Sprintf("Exchange rate CSV line %d has an invalid source currency.", line))
+		}
+		to, err := NewCurrency(record[1])
+		if err != nil {
+			return nil, rateCSVError(err, line, raw, fmt.Sprintf("Exchange rate CSV line %d has an invalid target currency.", line))
+		}
+		rate, err := NewExchangeRate(from, to, record[2], at, source)
+		if err != nil {
+			return nil, rateCSVError(err, line, raw, fmt.Sprintf("Exchange rate CSV line %d has an invalid rate.", line))
+		}
+		provider.Set(rate)
+	}
+	return provider, nil
+}
+
+func LoadRatesCSVFile(path string) (*StaticRateProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, msg.NewInternalError(err, msg.OperationContext{Operation: "open_rates_csv"}.ContextMap())
+	}
+	defer f.Close()
+	return LoadRatesCSV(f)
+}
+
+// Conversion registra o resultado de uma conversão e a cotação usada.
+type Conversion struct {
+	Source      Money
+	Result      Money
+	Rate        ExchangeRate
+	Rounding    RoundingMode
+	ConvertedAt time.Time
+}
+
+type Converter struct {
+	provider RateProvider
+	rounding RoundingMode
+	maxAge   time.Duration
+	clock    Clock
+}
+
+type ConverterOption func(*Converter)
+
+func WithConversionRounding(mode RoundingMode) ConverterOption {
+	return func(c *Converter) {
+		c.rounding = mode
+	}
+}
+
+// WithMaxRateAge rejeita cotações mais antigas que maxAge; zero desativa a verificação.
+func WithMaxRateAge(maxAge time.Duration) ConverterOption {
+	return func(c *Converter) {
+		c.maxAge = maxAge
+	}
+}
+
+// WithConverterClock fixa o relógio da conversão; sem esta opção, Convert usa ClockFromContext(ctx).
+func WithConverterClock(clock Clock) ConverterOption {
+	return func(c *Converter) {
+		c.clock = clock
+	}
+}
+
+func NewConverter(provider RateProvider, opts ...ConverterOption) (*Converter, error) {
+	c := &Converter{provider: provider, rounding: RoundHalfEven}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.provider == nil {
+		return nil, msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "rate_provider", Constraint: "required"}.ContextMap(),
+			"Converter requires a rate provider.",
+		)
+	}
+	if !c.rounding.IsValid() {
+		return nil, roundingModeError(c.rounding)
+	}
+	return c, nil
+}
+
+func (c *Converter) Convert(ctx context.Context, m Money, to Currency) (Conversion, error) {
+	if err := validateMoneyCurrency(to); err != nil {
+		return Conversion{}, err
+	}
+	clock := c.clock
+	if clock == nil {
+		clock = ClockFromContext(ctx)
+	}
+	now := clock.Now()
+	if m.currency == to {
+		identity := ExchangeRate{from: to, to: to, rate: big.NewRat(1, 1), at: now, source: "identity"}
+		return Conversion{Source: m, Result: m, Rate: identity, Rounding: c.rounding, ConvertedAt: now}, nil
+	}
+
+	rate, err := c.provider.Rate(ctx, m.currency, to)
+	if err != nil {
+		return Conversion{}, err
+	}
+	if c.maxAge > 0 && now.Sub(rate.at) > c.maxAge {
+		message := fmt.Sprintf("Exchange rate for %s from %s is older than %s.", currencyPair(m.currency, to), rate.at.Format(time.RFC3339), c.maxAge)
+		return Conversion{}, msg.NewDomainError(ErrStaleRate, message,
+			msg.ValidationContext{Field: "exchange_rate", Input: currencyPair(m.currency, to), Constraint: "max_age", Limit: c.maxAge.String()}.ContextMap(),
+		).WithContext(msg.KeyTimestamp, rate.at)
+	}
+
+	scaled := new(big.Rat).Mul(new(big.Rat).SetInt64(m.amount), rate.Rat())
+	if diff := to.MinorUnits() - m.currency.MinorUnits(); diff != 0 {
+		factor := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(absInt(diff))), nil))
+		if diff > 0 {
+			scaled.Mul(scaled, factor)
+		} else {
+			scaled.Quo(scaled, factor)
+		}
+	}
+	rounded := roundRat(scaled, c.rounding)
+	if !rounded.IsInt64() {
+		return Conversion{}, moneyOverflowError("convert", m.amount, rate)
+	}
+
+	return Conversion{
+		Source:      m,
+		Result:      Money{amount: rounded.Int64(), currency: to},
+		Rate:        rate,
+		Rounding:    c.rounding,
+		ConvertedAt: now,
+	}, nil
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
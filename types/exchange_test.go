@@ -0,0 +1,200 @@
+package types_test
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+var exchangeNow = time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+func TestNewExchangeRate(t *testing.T) {
+	rate, err := types.NewExchangeRate(types.USD, types.BRL, "5.4321", exchangeNow, "test")
+	require.NoError(t, err)
+	assert.Equal(t, types.USD, rate.From())
+	assert.Equal(t, types.BRL, rate.To())
+	assert.Equal(t, exchangeNow, rate.Timestamp())
+	assert.Equal(t, "test", rate.Source())
+	assert.Equal(t, "USD/BRL 5.4321", rate.String())
+
+	inverse := types.MustNewExchangeRate(types.USD, types.BRL, "4", exchangeNow, "test").Inverse()
+	assert.Equal(t, "BRL/USD 0.25", inverse.String())
+
+	for _, invalid := range []string{"0", "-1", "abc", ""} {
+		_, err := types.NewExchangeRate(types.USD, types.BRL, invalid, exchangeNow, "test")
+		requireMsgErrorCode(t, err, msg.CodeInvalid)
+	}
+	_, err = types.NewExchangeRate(types.USD, "ABC", "1", exchangeNow, "test")
+	requireMsgErrorCode(t, err, msg.CodeInvalid)
+}
+
+func TestStaticRateProvider(t *testing.T) {
+	ctx := context.Background()
+	provider := types.NewStaticRateProvider(types.MustNewExchangeRate(types.EUR, types.USD, "1.25", exchangeNow, "test"))
+
+	direct, err := provider.Rate(ctx, types.EUR, types.USD)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR/USD 1.25", direct.String())
+
+	inverse, err := provider.Rate(ctx, types.USD, types.EUR)
+	require.NoError(t, err)
+	assert.Equal(t, "USD/EUR 0.8", inverse.String())
+
+	_, err = provider.Rate(ctx, types.BRL, types.EUR)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeNotFound)
+	assert.ErrorIs(t, err, types.ErrRateNotFound)
+	assert.Equal(t, "BRL/EUR", msgErr.Context[msg.KeyInput])
+}
+
+func TestLoadRatesCSV(t *testing.T) {
+	t.Run("loads rates", func(t *testing.T) {
+		data := "from,to,rate,timestamp,source\nUSD,BRL,5.50,2026-10-16T12:00:00Z,bcb\neur,usd,1.1,2026-10-15T00:00:00Z\n"
+		provider, err := types.LoadRatesCSV(strings.NewReader(data))
+		require.NoError(t, err)
+
+		rate, err := provider.Rate(context.Background(), types.USD, types.BRL)
+		require.NoError(t, err)
+		assert.Equal(t, "USD/BRL 5.5", rate.String())
+		assert.Equal(t, "bcb", rate.Source())
+
+		rate, err = provider.Rate(context.Background(), types.EUR, types.USD)
+		require.NoError(t, err)
+		assert.Equal(t, msg.SourceCSV, rate.Source())
+	})
+
+	t.Run("reports the invalid line", func(t *testing.T) {
+		testCases := []string{
+			"USD,BRL,5.5\n",
+			"USD,BRL,abc,2026-10-16T12:00:00Z\n",
+			"USD,BRL,5.5,yesterday\n",
+			"USD,XYZ,5.5,2026-10-16T12:00:00Z\n",
+		}
+		for _, data := range testCases {
+			_, err := types.LoadRatesCSV(strings.NewReader("from,to,rate,timestamp\n" + data))
+			msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+			assert.Equal(t, 2, msgErr.Context[msg.KeyLine], "data %q", data)
+		}
+	})
+
+	t.Run("loads from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "rates.csv")
+		require.NoError(t, os.WriteFile(path, []byte("USD,BRL,5,2026-10-16T12:00:00Z\n"), 0o600))
+
+		provider, err := types.LoadRatesCSVFile(path)
+		require.NoError(t, err)
+		_, err = provider.Rate(context.Background(), types.USD, types.BRL)
+		assert.NoError(t, err)
+
+		_, err = types.LoadRatesCSVFile(filepath.Join(t.TempDir(), "missing.csv"))
+		requireMsgErrorCode(t, err, msg.CodeInternal)
+	})
+}
+
+func TestConverter_Convert(t *testing.T) {
+	ctx := context.Background()
+	provider := types.NewStaticRateProvider(
+		types.MustNewExchangeRate(types.USD, types.BRL, "5.4321", exchangeNow.Add(-time.Hour), "test"),
+		types.MustNewExchangeRate("JPY", types.BRL, "0.0365", exchangeNow, "test"),
+		types.MustNewExchangeRate(types.BRL, "KWD", "0.055", exchangeNow, "test"),
+	)
+	clock := types.WithConverterClock(types.NewFixedClock(exchangeNow))
+
+	t.Run("records rate and timestamp", func(t *testing.T) {
+		c, err := types.NewConverter(provider, clock)
+		require.NoError(t, err)
+
+		conv, err := c.Convert(ctx, types.MustNewMoney(1000, types.USD), types.BRL)
+		require.NoError(t, err)
+		assert.Equal(t, types.MustNewMoney(5432, types.BRL), conv.Result)
+		assert.Equal(t, types.MustNewMoney(1000, types.USD), conv.Source)
+		assert.Equal(t, exchangeNow.Add(-time.Hour), conv.Rate.Timestamp())
+		assert.Equal(t, types.RoundHalfEven, conv.Rounding)
+		assert.Equal(t, exchangeNow, conv.ConvertedAt)
+	})
+
+	t.Run("applies rounding mode", func(t *testing.T) {
+		halfEven, _ := types.NewConverter(provider, clock)
+		up, _ := types.NewConverter(provider, clock, types.WithConversionRounding(types.RoundUp))
+		down, _ := types.NewConverter(provider, clock, types.WithConversionRounding(types.RoundDown))
+
+		// BRL/USD usa a inversa de 5.4321: 100 centavos de BRL viram 18,409... centavos de USD.
+		m := types.MustNewMoney(100, types.BRL)
+		r1, err := halfEven.Convert(ctx, m, types.USD)
+		require.NoError(t, err)
+		r2, err := up.Convert(ctx, m, types.USD)
+		require.NoError(t, err)
+		r3, err := down.Convert(ctx, m, types.USD)
+		require.NoError(t, err)
+		assert.Equal(t, int64(18), r1.Result.Amount())
+		assert.Equal(t, int64(19), r2.Result.Amount())
+		assert.Equal(t, int64(18), r3.Result.Amount())
+	})
+
+	t.Run("adjusts minor units", func(t *testing.T) {
+		c, _ := types.NewConverter(provider, clock)
+
+		fromJPY, err := c.Convert(ctx, types.MustNewMoney(1000, "JPY"), types.BRL)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3650), fromJPY.Result.Amount(), "1000 JPY = 36.50 BRL")
+
+		toKWD, err := c.Convert(ctx, types.MustNewMoney(10000, types.BRL), "KWD")
+		require.NoError(t, err)
+		assert.Equal(t, int64(5500), toKWD.Result.Amount(), "100.00 BRL = 5.500 KWD")
+	})
+
+	t.Run("same currency is identity", func(t *testing.T) {
+		c, _ := types.NewConverter(provider, clock)
+		conv, err := c.Convert(ctx, types.MustNewMoney(7, types.EUR), types.EUR)
+		require.NoError(t, err)
+		assert.Equal(t, types.MustNewMoney(7, types.EUR), conv.Result)
+		assert.Equal(t, "identity", conv.Rate.Source())
+	})
+
+	t.Run("uses clock from context", func(t *testing.T) {
+		c, _ := types.NewConverter(provider)
+		clockCtx := types.ContextWithClock(ctx, types.NewFixedClock(exchangeNow))
+		conv, err := c.Convert(clockCtx, types.MustNewMoney(1000, types.USD), types.BRL)
+		require.NoError(t, err)
+		assert.Equal(t, exchangeNow, conv.ConvertedAt)
+	})
+
+	t.Run("missing rate", func(t *testing.T) {
+		c, _ := types.NewConverter(provider, clock)
+		_, err := c.Convert(ctx, types.MustNewMoney(1, types.EUR), types.BRL)
+		assert.ErrorIs(t, err, types.ErrRateNotFound)
+	})
+
+	t.Run("stale rate", func(t *testing.T) {
+		c, _ := types.NewConverter(provider, clock, types.WithMaxRateAge(30*time.Minute))
+		_, err := c.Convert(ctx, types.MustNewMoney(1, types.USD), types.BRL)
+		msgErr := requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+		assert.ErrorIs(t, err, types.ErrStaleRate)
+		assert.Equal(t, "30m0s", msgErr.Context[msg.KeyLimit])
+		assert.Equal(t, exchangeNow.Add(-time.Hour), msgErr.Context[msg.KeyTimestamp])
+	})
+
+	t.Run("overflow reports the rate", func(t *testing.T) {
+		c, _ := types.NewConverter(provider, clock)
+		_, err := c.Convert(ctx, types.MustNewMoney(math.MaxInt64/2, types.USD), types.BRL)
+		msgErr := requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+		assert.Equal(t, "convert", msgErr.Context[msg.KeyOperation])
+		assert.Contains(t, msgErr.Message, "USD/BRL 5.4321")
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		_, err := types.NewConverter(nil)
+		requireMsgErrorCode(t, err, msg.CodeInvalid)
+		_, err = types.NewConverter(provider, types.WithConversionRounding("nearest"))
+		requireMsgErrorCode(t, err, msg.CodeInvalid)
+	})
+}
@@ -1,10 +1,13 @@
 package types_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/marcelofabianov/gobrick/msg"
 	"github.com/marcelofabianov/gobrick/types"
 )
 
@@ -14,3 +17,12 @@ func mustNewTestUUID(t *testing.T) types.UUID {
 	require.NoError(t, err)
 	return id
 }
+
+func requireMsgErrorCode(t *testing.T, err error, code msg.ErrorCode) *msg.MessageError {
+	t.Helper()
+	require.Error(t, err)
+	var msgErr *msg.MessageError
+	require.True(t, errors.As(err, &msgErr), "error should be of type *msg.MessageError")
+	assert.Equal(t, code, msgErr.Code)
+	return msgErr
+}
@@ -0,0 +1,253 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+const MaxInstallments = 600
+
+type AmortizationSystem string
+
+const (
+	// AmortizationNone divide o principal sem juros.
+	AmortizationNone AmortizationSystem = "none"
+	// AmortizationPrice (Tabela Price / sistema francês) tem parcelas constantes.
+	AmortizationPrice AmortizationSystem = "price"
+	// AmortizationSAC tem amortização constante e parcelas decrescentes.
+	AmortizationSAC AmortizationSystem = "sac"
+)
+
+func (s AmortizationSystem) IsValid() bool {
+	switch s {
+	case AmortizationNone, AmortizationPrice, AmortizationSAC:
+		return true
+	default:
+		return false
+	}
+}
+
+type InstallmentRequest struct {
+	Principal Money
+	Count     int
+	System    AmortizationSystem
+	// MonthlyRate é a taxa mensal exata em fração decimal ("0.0199" para 1,99% a.m.).
+	MonthlyRate string
+	BillingDay  Day
+	// StartDate é a data da compra; a primeira parcela vence no primeiro BillingDay após ela.
+	StartDate time.Time
+	// Rounding é aplicado ao valor da parcela Price e aos juros; o padrão é RoundHalfEven.
+	Rounding RoundingMode
+}
+
+type Installment struct {
+	Number    int
+	DueDate   time.Time
+	Payment   Money
+	Principal Money
+	Interest  Money
+	Balance   Money
+}
+
+type InstallmentSchedule struct {
+	System        AmortizationSystem
+	Installments  []Installment
+	TotalPaid     Money
+	TotalInterest Money
+}
+
+func installmentError(input any, constraint, message string, limit any) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "installment", Input: input, Constraint: constraint, Limit: limit}.ContextMap(),
+		message,
+	)
+}
+
+func (req InstallmentRequest) validate() (*big.Rat, error) {
+	if err := validateMoneyCurrency(req.Principal.currency); err != nil {
+		return nil, err
+	}
+	if req.Principal.amount <= 0 {
+		return nil, installmentError(req.Principal.amount, "positive_principal", "Installment principal must be positive.", nil)
+	}
+	if req.Count < 1 || req.Count > MaxInstallments {
+		message := fmt.Sprintf("Installment count must be between 1 and %d, got %d.", MaxInstallments, req.Count)
+		return nil, installmentError(req.Count, "count_range", message, MaxInstallments)
+	}
+	if !req.System.IsValid() {
+		message := fmt.Sprintf("Amortization system '%s' is not valid.", req.System)
+		return nil, installmentError(string(req.System), "system", message, []AmortizationSystem{AmortizationNone, AmortizationPrice, AmortizationSAC})
+	}
+	if req.Rounding != "" && !req.Rounding.IsValid() {
+		return nil, roundingModeError(req.Rounding)
+	}
+	if req.BillingDay < 1 || req.BillingDay > 31 {
+		return nil, installmentError(req.BillingDay.Int(), "billing_day", ErrInvalidDay.Error(), 31)
+	}
+	if req.StartDate.IsZero() {
+		return nil, installmentError(nil, "start_date", "Installment start date is required.", nil)
+	}
+
+	rate := new(big.Rat)
+	if req.System != AmortizationNone {
+		r, ok := new(big.Rat).SetString(strings.TrimSpace(req.MonthlyRate))
+		if !ok || r.Sign() < 0 {
+			message := fmt.Sprintf("Monthly rate '%s' must be a non-negative decimal fraction.", req.MonthlyRate)
+			return nil, installmentError(req.MonthlyRate, "rate", message, nil)
+		}
+		rate = r
+	}
+	return rate, nil
+}
+
+// installmentDueDates retorna os vencimentos a partir do primeiro posterior a start,
+// com o dia de cobrança limitado ao último dia de meses curtos.
+func installmentDueDates(start time.Time, day Day, count int) []time.Time {
+	loc := start.Location()
+	ym := YearMonthOf(start)
+	// O dia já foi validado em InstallmentRequest.validate.
+	if first, _ := day.DateIn(ym); !first.After(DateOf(start)) {
+		ym = ym.Next()
+	}
+	dates := make([]time.Time, count)
+	for i := range dates {
+		due, _ := day.DateIn(ym.AddMonths(i))
+		dates[i] = due.In(loc)
+	}
+	return dates
+}
+
+func ratInt64(v int64) *big.Rat {
+	return new(big.Rat).SetInt64(v)
+}
+
+// CalculateInstallments monta o cronograma de parcelas; diferenças de arredondamento
+// são sempre absorvidas pela última parcela, que zera o saldo.
+func CalculateInstallments(req InstallmentRequest) (InstallmentSchedule, error) {
+	rate, err := req.validate()
+	if err != nil {
+		return InstallmentSchedule{}, err
+	}
+	rounding := req.Rounding
+	if rounding == "" {
+		rounding = RoundHalfEven
+	}
+
+	system := req.System
+	if rate.Sign() == 0 {
+		system = AmortizationNone
+	}
+
+	n := int64(req.Count)
+	principal := req.Principal.amount
+	currency := req.Principal.currency
+
+	var fixedPayment, fixedAmortization int64
+	switch system {
+	case AmortizationNone, AmortizationSAC:
+		fixedAmortization = principal / n
+	case AmortizationPrice:
+		// PMT = P * i / (1 - (1+i)^-n) = P * i * f / (f - 1), com f = (1+i)^n
+		f := new(big.Rat).SetInt64(1)
+		onePlusRate := new(big.Rat).Add(ratInt64(1), rate)
+		for k := int64(0); k < n; k++ {
+			f.Mul(f, onePlusRate)
+		}
+		pmt := new(big.Rat).Mul(ratInt64(principal), rate)
+		pmt.Mul(pmt, f)
+		pmt.Quo(pmt, new(big.Rat).Sub(f, ratInt64(1)))
+		rounded := roundRat(pmt, rounding)
+		if !rounded.IsInt64() {
+			return InstallmentSchedule{}, moneyOverflowError("installment", principal, rate.RatString())
+		}
+		fixedPayment = rounded.Int64()
+	}
+
+	dueDates := installmentDueDates(req.StartDate, req.BillingDay, req.Count)
+	schedule := InstallmentSchedule{System: system, Installments: make([]Installment, 0, req.Count)}
+	balance := principal
+	var totalPaid, totalInterest int64
+	for i := 0; i < req.Count; i++ {
+		roundedInterest := roundRat(new(big.Rat).Mul(ratInt64(balance), rate), rounding)
+		if !roundedInterest.IsInt64() {
+			return InstallmentSchedule{}, moneyOverflowError("installment", balance, rate.RatString())
+		}
+		interest := roundedInterest.Int64()
+
+		var amortization int64
+		switch {
+		case i == req.Count-1:
+			amortization = balance
+		case system == AmortizationPrice:
+			amortization = fixedPayment - interest
+		default:
+			amortization = fixedAmortization
+		}
+		balance -= amortization
+		payment, ok := addInt64(amortization, interest)
+		if ok {
+			totalPaid, ok = addInt64(totalPaid, payment)
+		}
+		if !ok {
+			return InstallmentSchedule{}, moneyOverflowError("installment", amortization, interest)
+		}
+		totalInterest += interest
+
+		schedule.Installments = append(schedule.Installments, Installment{
+			Number:    i + 1,
+			DueDate:   dueDates[i],
+			Payment:   Money{amount: payment, currency: currency},
+			Principal: Money{amount: amortization, currency: currency},
+			Interest:  Money{amount: interest, currency: currency},
+			Balance:   Money{amount: balance, currency: currency},
+		})
+	}
+	schedule.TotalPaid = Money{amount: totalPaid, currency: currency}
+	schedule.TotalInterest = Money{amount: totalInterest, currency: currency}
+	return schedule, nil
+}
+
+func (s InstallmentSchedule) Count() int {
+	return len(s.Installments)
+}
+
+// Label resume o parcelamento para exibição, ex.: "12x de R$ 99,90" (pt-BR) ou "12x $99.90" (en-US).
+// Quando as parcelas diferem (SAC ou sobra de arredondamento) mostra a menor e a maior:
+// "3x de R$ 33,33 a R$ 33,34" ou "3x $33.33 to $33.34".
+func (s InstallmentSchedule) Label(locale Locale) (string, error) {
+	if len(s.Installments) == 0 {
+		return "", installmentError(0, "count_range", "Installment schedule is empty.", MaxInstallments)
+	}
+	lowest, highest := s.Installments[0].Payment, s.Installments[0].Payment
+	for _, inst := range s.Installments[1:] {
+		if inst.Payment.amount < lowest.amount {
+			lowest = inst.Payment
+		}
+		if inst.Payment.amount > highest.amount {
+			highest = inst.Payment
+		}
+	}
+	amount, err := lowest.Format(locale)
+	if err != nil {
+		return "", err
+	}
+	if highest.amount != lowest.amount {
+		upper, err := highest.Format(locale)
+		if err != nil {
+			return "", err
+		}
+		if locale == LocalePtBR {
+			amount += " a " + upper
+		} else {
+			amount += " to " + upper
+		}
+	}
+	if locale == LocalePtBR {
+		return fmt.Sprintf("%dx de %s", len(s.Installments), amount), nil
+	}
+	return fmt.Sprintf("%dx %s", len(s.Installments), amount), nil
+}
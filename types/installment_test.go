@@ -0,0 +1,222 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func assertScheduleConsistent(t *testing.T, principal int64, schedule types.InstallmentSchedule) {
+	t.Helper()
+	var amortized, paid, interest int64
+	for _, inst := range schedule.Installments {
+		assert.Equal(t, inst.Principal.Amount()+inst.Interest.Amount(), inst.Payment.Amount(), "installment %d", inst.Number)
+		amortized += inst.Principal.Amount()
+		paid += inst.Payment.Amount()
+		interest += inst.Interest.Amount()
+	}
+	assert.Equal(t, principal, amortized, "principal must be fully amortized")
+	assert.Equal(t, paid, schedule.TotalPaid.Amount())
+	assert.Equal(t, interest, schedule.TotalInterest.Amount())
+	assert.True(t, schedule.Installments[len(schedule.Installments)-1].Balance.IsZero())
+}
+
+func TestCalculateInstallments_NoInterest(t *testing.T) {
+	schedule, err := types.CalculateInstallments(types.InstallmentRequest{
+		Principal:  types.MustNewMoney(119880, types.BRL),
+		Count:      12,
+		System:     types.AmortizationNone,
+		BillingDay: types.Day(10),
+		StartDate:  time.Date(2026, time.October, 16, 15, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 12, schedule.Count())
+	assertScheduleConsistent(t, 119880, schedule)
+	assert.Equal(t, int64(9990), schedule.Installments[0].Payment.Amount())
+	assert.True(t, schedule.TotalInterest.IsZero())
+
+	label, err := schedule.Label(types.LocalePtBR)
+	require.NoError(t, err)
+	assert.Equal(t, "12x de R$ 99,90", label)
+
+	label, err = schedule.Label(types.LocaleEnUS)
+	require.NoError(t, err)
+	assert.Equal(t, "12x R$99.90", label)
+}
+
+func TestCalculateInstallments_RemainderOnLastInstallment(t *testing.T) {
+	schedule, err := types.CalculateInstallments(types.InstallmentRequest{
+		Principal:  types.MustNewMoney(10000, types.BRL),
+		Count:      3,
+		System:     types.AmortizationNone,
+		BillingDay: types.Day(5),
+		StartDate:  time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3333, 3333, 3334}, []int64{
+		schedule.Installments[0].Payment.Amount(),
+		schedule.Installments[1].Payment.Amount(),
+		schedule.Installments[2].Payment.Amount(),
+	})
+
+	label, err := schedule.Label(types.LocalePtBR)
+	require.NoError(t, err)
+	assert.Equal(t, "3x de R$ 33,33 a R$ 33,34", label)
+
+	label, err = schedule.Label(types.LocaleEnUS)
+	require.NoError(t, err)
+	assert.Equal(t, "3x R$33.33 to R$33.34", label)
+}
+
+func TestCalculateInstallments_Price(t *testing.T) {
+	schedule, err := types.CalculateInstallments(types.InstallmentRequest{
+		Principal:   types.MustNewMoney(100000, types.BRL),
+		Count:       12,
+		System:      types.AmortizationPrice,
+		MonthlyRate: "0.0199",
+		BillingDay:  types.Day(15),
+		StartDate:   time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assertScheduleConsistent(t, 100000, schedule)
+
+	// PMT = 1000 * 0.0199 / (1 - 1.0199^-12) = 94,50...
+	first := schedule.Installments[0]
+	assert.Equal(t, int64(9450), first.Payment.Amount())
+	assert.Equal(t, int64(1990), first.Interest.Amount())
+	assert.Equal(t, int64(7460), first.Principal.Amount())
+	assert.Equal(t, int64(92540), first.Balance.Amount())
+	for _, inst := range schedule.Installments[:11] {
+		assert.Equal(t, int64(9450), inst.Payment.Amount())
+	}
+	last := schedule.Installments[11]
+	assert.InDelta(t, 9450, last.Payment.Amount(), 5, "last installment only absorbs rounding")
+}
+
+func TestCalculateInstallments_SAC(t *testing.T) {
+	schedule, err := types.CalculateInstallments(types.InstallmentRequest{
+		Principal:   types.MustNewMoney(120000, types.BRL),
+		Count:       12,
+		System:      types.AmortizationSAC,
+		MonthlyRate: "0.01",
+		BillingDay:  types.Day(1),
+		StartDate:   time.Date(2026, time.January, 20, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assertScheduleConsistent(t, 120000, schedule)
+
+	assert.Equal(t, int64(10000), schedule.Installments[0].Principal.Amount())
+	assert.Equal(t, int64(1200), schedule.Installments[0].Interest.Amount())
+	assert.Equal(t, int64(11200), schedule.Installments[0].Payment.Amount())
+	assert.Equal(t, int64(1100), schedule.Installments[1].Interest.Amount())
+	assert.Equal(t, int64(10100), schedule.Installments[11].Payment.Amount())
+	assert.Equal(t, int64(7800), schedule.TotalInterest.Amount())
+
+	label, err := schedule.Label(types.LocalePtBR)
+	require.NoError(t, err)
+	assert.Equal(t, "12x de R$ 101,00 a R$ 112,00", label)
+}
+
+func TestCalculateInstallments_Overflow(t *testing.T) {
+	_, err := types.CalculateInstallments(types.InstallmentRequest{
+		Principal:   types.MustNewMoney(math.MaxInt64/2, types.BRL),
+		Count:       2,
+		System:      types.AmortizationPrice,
+		MonthlyRate: "1000",
+		BillingDay:  types.Day(1),
+		StartDate:   time.Date(2026, time.January, 20, 0, 0, 0, 0, time.UTC),
+	})
+	msgErr := requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+	assert.Equal(t, "overflow", msgErr.Context[msg.KeyConstraint])
+	assert.Equal(t, "installment", msgErr.Context[msg.KeyOperation])
+}
+
+func TestCalculateInstallments_ZeroRateFallsBackToNoInterest(t *testing.T) {
+	schedule, err := types.CalculateInstallments(types.InstallmentRequest{
+		Principal:   types.MustNewMoney(1000, types.BRL),
+		Count:       4,
+		System:      types.AmortizationPrice,
+		MonthlyRate: "0",
+		BillingDay:  types.Day(1),
+		StartDate:   time.Date(2026, time.January, 20, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.AmortizationNone, schedule.System)
+	assert.Equal(t, int64(250), schedule.Installments[0].Payment.Amount())
+}
+
+func TestCalculateInstallments_DueDates(t *testing.T) {
+	loc := time.FixedZone("BRT", -3*60*60)
+	schedule, err := types.CalculateInstallments(types.InstallmentRequest{
+		Principal:  types.MustNewMoney(400, types.BRL),
+		Count:      4,
+		System:     types.AmortizationNone,
+		BillingDay: types.Day(31),
+		StartDate:  time.Date(2023, time.December, 31, 22, 0, 0, 0, loc),
+	})
+	require.NoError(t, err)
+
+	expected := []time.Time{
+		time.Date(2024, time.January, 31, 0, 0, 0, 0, loc),
+		time.Date(2024, time.February, 29, 0, 0, 0, 0, loc),
+		time.Date(2024, time.March, 31, 0, 0, 0, 0, loc),
+		time.Date(2024, time.April, 30, 0, 0, 0, 0, loc),
+	}
+	for i, inst := range schedule.Installments {
+		assert.True(t, expected[i].Equal(inst.DueDate), "installment %d due %s", inst.Number, inst.DueDate)
+	}
+
+	sameMonth, err := types.CalculateInstallments(types.InstallmentRequest{
+		Principal:  types.MustNewMoney(100, types.BRL),
+		Count:      1,
+		System:     types.AmortizationNone,
+		BillingDay: types.Day(20),
+		StartDate:  time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC), sameMonth.Installments[0].DueDate)
+}
+
+func TestCalculateInstallments_Validation(t *testing.T) {
+	valid := types.InstallmentRequest{
+		Principal:   types.MustNewMoney(1000, types.BRL),
+		Count:       3,
+		System:      types.AmortizationPrice,
+		MonthlyRate: "0.02",
+		BillingDay:  types.Day(10),
+		StartDate:   time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name       string
+		mutate     func(r *types.InstallmentRequest)
+		constraint string
+	}{
+		{"non-positive principal", func(r *types.InstallmentRequest) { r.Principal = types.MustNewMoney(0, types.BRL) }, "positive_principal"},
+		{"zero count", func(r *types.InstallmentRequest) { r.Count = 0 }, "count_range"},
+		{"too many installments", func(r *types.InstallmentRequest) { r.Count = types.MaxInstallments + 1 }, "count_range"},
+		{"unknown system", func(r *types.InstallmentRequest) { r.System = "german" }, "system"},
+		{"invalid rate", func(r *types.InstallmentRequest) { r.MonthlyRate = "abc" }, "rate"},
+		{"negative rate", func(r *types.InstallmentRequest) { r.MonthlyRate = "-0.01" }, "rate"},
+		{"invalid billing day", func(r *types.InstallmentRequest) { r.BillingDay = 0 }, "billing_day"},
+		{"missing start date", func(r *types.InstallmentRequest) { r.StartDate = time.Time{} }, "start_date"},
+		{"invalid rounding", func(r *types.InstallmentRequest) { r.Rounding = "nearest" }, "enum"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := valid
+			tc.mutate(&req)
+			_, err := types.CalculateInstallments(req)
+			msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+			assert.Equal(t, tc.constraint, msgErr.Context[msg.KeyConstraint])
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+// Money representa um valor monetário em unidades mínimas (centavos para BRL) de uma Currency.
+type Money struct {
+	amount   int64
+	currency Currency
+}
+
+type moneyJSON struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+func validateMoneyCurrency(currency Currency) error {
+	if !currency.IsValid() {
+		message := fmt.Sprintf("Money currency '%s' is not valid.", currency)
+		return msg.NewValidationError(ErrInvalidCurrency,
+			msg.ValidationContext{Field: "money", Input: currency.String(), Constraint: "currency"}.ContextMap(),
+			message,
+		)
+	}
+	return nil
+}
+
+func NewMoney(amount int64, currency Currency) (Money, error) {
+	if err := validateMoneyCurrency(currency); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+func MustNewMoney(amount int64, currency Currency) Money {
+	m, err := NewMoney(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func NewZeroMoney(currency Currency) (Money, error) {
+	return NewMoney(0, currency)
+}
+
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.amount > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+func (m Money) String() string {
+	return fmt.Sprintf("%d %s", m.amount, m.currency)
+}
+
+func (m Money) ensureSameCurrency(other Money) error {
+	if m.currency != other.currency {
+		message := fmt.Sprintf("Cannot operate on Money with different currencies (%s and %s).", m.currency, other.currency)
+		return msg.NewDomainError(nil, message,
+			msg.ValidationContext{Field: "money", Input: other.currency.String(), Constraint: "same_currency", Limit: m.currency.String()}.ContextMap(),
+		)
+	}
+	return nil
+}
+
+func moneyOverflowError(operation string, a, b any) error {
+	message := fmt.Sprintf("Money %s overflows int64 (%v, %v).", operation, a, b)
+	return msg.NewDomainError(nil, message,
+		msg.ValidationContext{Field: "money", Constraint: "overflow"}.ContextMap(),
+	).WithContext(msg.KeyOperation, operation)
+}
+
+func addInt64(a, b int64) (int64, bool) {
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return 0, false
+	}
+	return a + b, true
+}
+
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	result := a * b
+	if result/b != a {
+		return 0, false
+	}
+	return result, true
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.ensureSameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	sum, ok := addInt64(m.amount, other.amount)
+	if !ok {
+		return Money{}, moneyOverflowError("add", m.amount, other.amount)
+	}
+	return Money{amount: sum, currency: m.currency}, nil
+}
+
+func (m Money) Subtract(other Money) (Money, error) {
+	if err := m.ensureSameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	if other.amount == math.MinInt64 {
+		return Money{}, moneyOverflowError("subtract", m.amount, other.amount)
+	}
+	diff, ok := addInt64(m.amount, -other.amount)
+	if !ok {
+		return Money{}, moneyOverflowError("subtract", m.amount, other.amount)
+	}
+	return Money{amount: diff, currency: m.currency}, nil
+}
+
+func (m Money) Multiply(factor int64) (Money, error) {
+	product, ok := mulInt64(m.amount, factor)
+	if !ok {
+		return Money{}, moneyOverflowError("multiply", m.amount, factor)
+	}
+	return Money{amount: product, currency: m.currency}, nil
+}
+
+func (m Money) Negate() (Money, error) {
+	if m.amount == math.MinInt64 {
+		return Money{}, moneyOverflowError("negate", m.amount, -1)
+	}
+	return Money{amount: -m.amount, currency: m.currency}, nil
+}
+
+func (m Money) Abs() (Money, error) {
+	if m.amount < 0 {
+		return m.Negate()
+	}
+	return m, nil
+}
+
+// Compare retorna -1, 0 ou 1; valores em moedas diferentes não são comparáveis.
+func (m Money) Compare(other Money) (int, error) {
+	if err := m.ensureSameCurrency(other); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.amount < other.amount:
+		return -1, nil
+	case m.amount > other.amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+func (m Money) Equals(other Money) bool {
+	return m.currency == other.currency && m.amount == other.amount
+}
+
+func (m Money) GreaterThan(other Money) (bool, error) {
+	cmp, err := m.Compare(other)
+	return cmp > 0, err
+}
+
+func (m Money) LessThan(other Money) (bool, error) {
+	cmp, err := m.Compare(other)
+	return cmp < 0, err
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: m.amount, Currency: m.currency})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var raw moneyJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		message := fmt.Sprintf("Money must be a JSON object with integer amount and currency (received: %s).", string(data))
+		return msg.NewValidationError(err,
+			msg.ConversionContext{TargetType: "Money", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
+			message,
+		)
+	}
+	money, err := NewMoney(raw.Amount, raw.Currency)
+	if err != nil {
+		return err
+	}
+	*m = money
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (m *Money) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	case nil:
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "Money", Source: msg.SourceDB}.ContextMap(),
+			"Scanned nil value for non-nullable Money.",
+		)
+	default:
+		message := fmt.Sprintf("Incompatible type (%T) for Money. Expected JSON string or []byte.", src)
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "Money", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
+	}
+	if err := m.UnmarshalJSON(data); err != nil {
+		if msgErr, ok := err.(*msg.MessageError); ok {
+			msgErr.WithContext(msg.KeySource, msg.SourceDB)
+			return msgErr
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+// Share é a participação de uma parte na alocação: peso relativo e valor mínimo em unidades mínimas.
+type Share struct {
+	Weight  int64
+	Minimum int64
+}
+
+func allocationError(input any, constraint, message string, limit any) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "allocation", Input: input, Constraint: constraint, Limit: limit}.ContextMap(),
+		message,
+	)
+}
+
+// Allocate divide o valor pelos pesos informados (ex.: 70, 20, 10) sem perder centavos.
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	shares := make([]Share, len(ratios))
+	for i, r := range ratios {
+		shares[i] = Share{Weight: r}
+	}
+	return m.AllocateShares(shares)
+}
+
+// Split divide o valor em n partes iguais; as sobras vão para as primeiras partes.
+func (m Money) Split(n int) ([]Money, error) {
+	if n < 1 {
+		return nil, allocationError(n, "min_parts", fmt.Sprintf("Money can only be split into at least 1 part, got %d.", n), 1)
+	}
+	ratios := make([]int64, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// AllocateShares reserva primeiro o mínimo de cada parte e distribui o restante pelos pesos.
+// As sobras de arredondamento vão, uma unidade por vez, às partes com maior resto
+// fracionário; empates favorecem a parte de menor índice. Valores negativos são
+// alocados pelo módulo e as partes retornam negativas.
+func (m Money) AllocateShares(shares []Share) ([]Money, error) {
+	if len(shares) == 0 {
+		return nil, allocationError(0, "required", "Allocation requires at least one share.", nil)
+	}
+
+	totalWeight := new(big.Int)
+	totalMinimum := new(big.Int)
+	for i, s := range shares {
+		if s.Weight < 0 {
+			return nil, allocationError(s.Weight, "non_negative", fmt.Sprintf("Allocation weight at position %d cannot be negative (%d).", i, s.Weight), 0)
+		}
+		if s.Minimum < 0 {
+			return nil, allocationError(s.Minimum, "non_negative", fmt.Sprintf("Allocation minimum at position %d cannot be negative (%d).", i, s.Minimum), 0)
+		}
+		totalWeight.Add(totalWeight, big.NewInt(s.Weight))
+		totalMinimum.Add(totalMinimum, big.NewInt(s.Minimum))
+	}
+	if totalWeight.Sign() == 0 {
+		return nil, allocationError(totalWeight.String(), "positive_total", "Allocation weights must sum to a positive value.", nil)
+	}
+
+	total := new(big.Int).SetUint64(absUint64(m.amount))
+	if totalMinimum.Cmp(total) > 0 {
+		message := fmt.Sprintf("Allocation minimums (%s) exceed the amount to allocate (%s).", totalMinimum, total)
+		return nil, allocationError(totalMinimum.String(), "minimum_total", message, total.String())
+	}
+
+	remaining := new(big.Int).Sub(total, totalMinimum)
+	parts := make([]*big.Int, len(shares))
+	remainders := make([]*big.Int, len(shares))
+	distributed := new(big.Int)
+	for i, s := range shares {
+		product := new(big.Int).Mul(remaining, big.NewInt(s.Weight))
+		parts[i], remainders[i] = new(big.Int).QuoRem(product, totalWeight, new(big.Int))
+		distributed.Add(distributed, parts[i])
+	}
+
+	order := make([]int, len(shares))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+	leftover := new(big.Int).Sub(remaining, distributed).Int64()
+	for k := int64(0); k < leftover; k++ {
+		i := order[k]
+		parts[i].Add(parts[i], big.NewInt(1))
+	}
+
+	result := make([]Money, len(shares))
+	for i, s := range shares {
+		part := new(big.Int).Add(parts[i], big.NewInt(s.Minimum))
+		if m.amount < 0 {
+			part.Neg(part)
+		}
+		result[i] = Money{amount: part.Int64(), currency: m.currency}
+	}
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func moneyAmounts(parts []types.Money) []int64 {
+	amounts := make([]int64, len(parts))
+	for i, p := range parts {
+		amounts[i] = p.Amount()
+	}
+	return amounts
+}
+
+func sumAmounts(parts []types.Money) int64 {
+	var total int64
+	for _, p := range parts {
+		total += p.Amount()
+	}
+	return total
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amount   int64
+		ratios   []int64
+		expected []int64
+	}{
+		{"70/20/10 exact", 10000, []int64{70, 20, 10}, []int64{7000, 2000, 1000}},
+		{"70/20/10 with remainder", 1001, []int64{70, 20, 10}, []int64{701, 200, 100}},
+		{"remainder goes to largest fraction", 100, []int64{1, 1, 1}, []int64{34, 33, 33}},
+		{"largest fraction wins over index", 10, []int64{1, 2}, []int64{3, 7}},
+		{"zero weight gets nothing", 5, []int64{1, 0, 1}, []int64{3, 0, 2}},
+		{"negative amount", -1001, []int64{70, 20, 10}, []int64{-701, -200, -100}},
+		{"zero amount", 0, []int64{1, 1}, []int64{0, 0}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := types.MustNewMoney(tc.amount, types.BRL)
+			parts, err := m.Allocate(tc.ratios...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, moneyAmounts(parts))
+			assert.Equal(t, tc.amount, sumAmounts(parts))
+			for _, p := range parts {
+				assert.Equal(t, types.BRL, p.Currency())
+			}
+		})
+	}
+
+	t.Run("large amounts do not overflow", func(t *testing.T) {
+		parts, err := types.MustNewMoney(math.MaxInt64, types.USD).Allocate(math.MaxInt64, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(math.MaxInt64), parts[0].Amount()+parts[1].Amount())
+	})
+}
+
+func TestMoney_Split(t *testing.T) {
+	parts, err := types.MustNewMoney(1000, types.BRL).Split(3)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{334, 333, 333}, moneyAmounts(parts))
+
+	parts, err = types.MustNewMoney(2, "JPY").Split(4)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 1, 0, 0}, moneyAmounts(parts))
+
+	_, err = types.MustNewMoney(1, types.BRL).Split(0)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "min_parts", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestMoney_AllocateShares(t *testing.T) {
+	t.Run("honours minimum shares", func(t *testing.T) {
+		m := types.MustNewMoney(1000, types.BRL)
+		parts, err := m.AllocateShares([]types.Share{
+			{Weight: 90},
+			{Weight: 10, Minimum: 300},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{630, 370}, moneyAmounts(parts))
+	})
+
+	t.Run("minimum only share", func(t *testing.T) {
+		m := types.MustNewMoney(500, types.BRL)
+		parts, err := m.AllocateShares([]types.Share{{Weight: 1}, {Minimum: 200}})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{300, 200}, moneyAmounts(parts))
+	})
+
+	t.Run("rejects invalid shares", func(t *testing.T) {
+		m := types.MustNewMoney(100, types.BRL)
+		testCases := []struct {
+			name       string
+			shares     []types.Share
+			constraint string
+		}{
+			{"empty", nil, "required"},
+			{"negative weight", []types.Share{{Weight: 1}, {Weight: -1}}, "non_negative"},
+			{"negative minimum", []types.Share{{Weight: 1, Minimum: -1}}, "non_negative"},
+			{"zero total", []types.Share{{Weight: 0}, {Weight: 0}}, "positive_total"},
+			{"minimums exceed amount", []types.Share{{Weight: 1, Minimum: 60}, {Weight: 1, Minimum: 50}}, "minimum_total"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := m.AllocateShares(tc.shares)
+				msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+				assert.Equal(t, tc.constraint, msgErr.Context[msg.KeyConstraint])
+			})
+		}
+	})
+}
@@ -0,0 +1,230 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+type Locale string
+
+const (
+	LocalePtBR Locale = "pt-BR"
+	LocaleEnUS Locale = "en-US"
+)
+
+type localeFormat struct {
+	decimalSep string
+	groupSep   string
+	// symbolSpace força espaço entre símbolo e número; sem ele, o espaço só é
+	// usado quando o símbolo termina em letra (ex.: "CHF 10.00").
+	symbolSpace bool
+}
+
+var localeFormats = map[Locale]localeFormat{
+	LocalePtBR: {decimalSep: ",", groupSep: ".", symbolSpace: true},
+	LocaleEnUS: {decimalSep: ".", groupSep: ",", symbolSpace: false},
+}
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+func (l Locale) IsValid() bool {
+	_, ok := localeFormats[l]
+	return ok
+}
+
+func localeFormatFor(locale Locale, input string) (localeFormat, error) {
+	lf, ok := localeFormats[locale]
+	if !ok {
+		message := fmt.Sprintf("Locale '%s' is not supported for Money formatting.", locale)
+		return localeFormat{}, msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "money", Input: input, Constraint: "locale", Limit: []Locale{LocalePtBR, LocaleEnUS}}.ContextMap(),
+			message,
+		).WithContext(msg.KeyLocale, locale.String())
+	}
+	return lf, nil
+}
+
+func (lf localeFormat) symbolPrefix(currency Currency) string {
+	symbol := currency.Symbol()
+	if symbol == "" {
+		symbol = currency.String()
+	}
+	last, _ := lastRune(symbol)
+	if lf.symbolSpace || unicode.IsLetter(last) {
+		return symbol + " "
+	}
+	return symbol
+}
+
+func lastRune(s string) (rune, bool) {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return 0, false
+	}
+	return runes[len(runes)-1], true
+}
+
+func (lf localeFormat) formatDigits(abs uint64, minorUnits int) string {
+	digits := strconv.FormatUint(abs, 10)
+	if len(digits) <= minorUnits {
+		digits = strings.Repeat("0", minorUnits-len(digits)+1) + digits
+	}
+	intPart := digits[:len(digits)-minorUnits]
+	fracPart := digits[len(digits)-minorUnits:]
+
+	var b strings.Builder
+	for i, r := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(lf.groupSep)
+		}
+		b.WriteRune(r)
+	}
+	if minorUnits > 0 {
+		b.WriteString(lf.decimalSep)
+		b.WriteString(fracPart)
+	}
+	return b.String()
+}
+
+func absUint64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+	return uint64(v)
+}
+
+// Format renderiza o valor no padrão do locale: "R$ 1.234,56" (pt-BR) ou "$1,234.56" (en-US).
+func (m Money) Format(locale Locale) (string, error) {
+	return m.format(locale, false)
+}
+
+// FormatAccounting usa parênteses para valores negativos: "(R$ 1.234,56)".
+func (m Money) FormatAccounting(locale Locale) (string, error) {
+	return m.format(locale, true)
+}
+
+func (m Money) format(locale Locale, accounting bool) (string, error) {
+	lf, err := localeFormatFor(locale, m.String())
+	if err != nil {
+		return "", err
+	}
+	body := lf.symbolPrefix(m.currency) + lf.formatDigits(absUint64(m.amount), m.currency.MinorUnits())
+	if m.amount >= 0 {
+		return body, nil
+	}
+	if accounting {
+		return "(" + body + ")", nil
+	}
+	return "-" + body, nil
+}
+
+func moneyParseError(input string, locale Locale, constraint, message string) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "money", Input: input, Constraint: constraint}.ContextMap(),
+		message,
+	).WithContext(msg.KeyLocale, locale.String())
+}
+
+// ParseMoney interpreta valores digitados no padrão do locale, com ou sem símbolo,
+// aceitando sinal negativo ou parênteses (estilo contábil).
+func ParseMoney(input string, currency Currency, locale Locale) (Money, error) {
+	lf, err := localeFormatFor(locale, input)
+	if err != nil {
+		return Money{}, err
+	}
+	if err := validateMoneyCurrency(currency); err != nil {
+		return Money{}, err
+	}
+
+	s := strings.TrimSpace(input)
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = strings.TrimSpace(s[1 : len(s)-1])
+	}
+	if !negative && strings.HasPrefix(s, "-") {
+		negative = true
+		s = strings.TrimSpace(s[1:])
+	}
+	for _, prefix := range []string{currency.Symbol(), currency.String()} {
+		if prefix != "" && strings.HasPrefix(s, prefix) {
+			s = strings.TrimSpace(strings.TrimPrefix(s, prefix))
+			break
+		}
+	}
+	if !negative && strings.HasPrefix(s, "-") {
+		negative = true
+		s = strings.TrimSpace(s[1:])
+	}
+	if s == "" {
+		return Money{}, moneyParseError(input, locale, "required", fmt.Sprintf("Money value '%s' has no amount.", input))
+	}
+
+	minorUnits := currency.MinorUnits()
+	intPart, fracPart, hasDecimal := strings.Cut(s, lf.decimalSep)
+	if !isDigits(fracPart) || !validGrouping(intPart, lf.groupSep) {
+		message := fmt.Sprintf("Money value '%s' has an invalid format for locale %s.", input, locale)
+		return Money{}, moneyParseError(input, locale, "format", message)
+	}
+	if hasDecimal && (minorUnits == 0 || fracPart == "" || len(fracPart) > minorUnits) {
+		message := fmt.Sprintf("Money value '%s' must have at most %d decimal places for %s.", input, minorUnits, currency)
+		return Money{}, moneyParseError(input, locale, "decimal_places", message)
+	}
+
+	digits := strings.ReplaceAll(intPart, lf.groupSep, "") + fracPart + strings.Repeat("0", minorUnits-len(fracPart))
+	abs, err := strconv.ParseUint(digits, 10, 64)
+	limit := uint64(math.MaxInt64)
+	if negative {
+		limit++
+	}
+	if err != nil || abs > limit {
+		message := fmt.Sprintf("Money value '%s' is out of range.", input)
+		return Money{}, moneyParseError(input, locale, "range", message)
+	}
+
+	var amount int64
+	switch {
+	case negative && abs == limit:
+		amount = math.MinInt64
+	case negative:
+		amount = -int64(abs)
+	default:
+		amount = int64(abs)
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func validGrouping(intPart, groupSep string) bool {
+	if intPart == "" {
+		return false
+	}
+	groups := strings.Split(intPart, groupSep)
+	for i, g := range groups {
+		if g == "" || !isDigits(g) {
+			return false
+		}
+		if i == 0 && len(groups) > 1 && len(g) > 3 {
+			return false
+		}
+		if i > 0 && len(g) != 3 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestMoney_Format(t *testing.T) {
+	testCases := []struct {
+		name       string
+		money      types.Money
+		locale     types.Locale
+		expected   string
+		accounting string
+	}{
+		{"pt-BR BRL", types.MustNewMoney(123456, types.BRL), types.LocalePtBR, "R$ 1.234,56", "R$ 1.234,56"},
+		{"en-US USD", types.MustNewMoney(123456, types.USD), types.LocaleEnUS, "$1,234.56", "$1,234.56"},
+		{"pt-BR negative", types.MustNewMoney(-123456, types.BRL), types.LocalePtBR, "-R$ 1.234,56", "(R$ 1.234,56)"},
+		{"en-US negative", types.MustNewMoney(-5, types.USD), types.LocaleEnUS, "-$0.05", "($0.05)"},
+		{"zero", types.MustNewMoney(0, types.BRL), types.LocalePtBR, "R$ 0,00", "R$ 0,00"},
+		{"en-US BRL", types.MustNewMoney(100, types.BRL), types.LocaleEnUS, "R$1.00", "R$1.00"},
+		{"JPY has no decimals", types.MustNewMoney(1234567, "JPY"), types.LocaleEnUS, "¥1,234,567", "¥1,234,567"},
+		{"KWD has three decimals", types.MustNewMoney(1234567, "KWD"), types.LocalePtBR, "د.ك 1.234,567", "د.ك 1.234,567"},
+		{"letter symbol gets a space", types.MustNewMoney(1000, "CHF"), types.LocaleEnUS, "CHF 10.00", "CHF 10.00"},
+		{"min int64", types.MustNewMoney(math.MinInt64, types.USD), types.LocaleEnUS, "-$92,233,720,368,547,758.08", "($92,233,720,368,547,758.08)"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			formatted, err := tc.money.Format(tc.locale)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, formatted)
+
+			accounting, err := tc.money.FormatAccounting(tc.locale)
+			require.NoError(t, err)
+			assert.Equal(t, tc.accounting, accounting)
+		})
+	}
+
+	_, err := types.MustNewMoney(1, types.BRL).Format(types.Locale("fr-FR"))
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "locale", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestParseMoney(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		currency types.Currency
+		locale   types.Locale
+		expected int64
+	}{
+		{"pt-BR full", "R$ 1.234,56", types.BRL, types.LocalePtBR, 123456},
+		{"pt-BR without symbol", "1234,5", types.BRL, types.LocalePtBR, 123450},
+		{"pt-BR integer", "1.234", types.BRL, types.LocalePtBR, 123400},
+		{"pt-BR negative", "-R$ 10,00", types.BRL, types.LocalePtBR, -1000},
+		{"pt-BR negative after symbol", "R$ -10,00", types.BRL, types.LocalePtBR, -1000},
+		{"pt-BR accounting", "(R$ 1.234,56)", types.BRL, types.LocalePtBR, -123456},
+		{"en-US full", "$1,234.56", types.USD, types.LocaleEnUS, 123456},
+		{"en-US ISO code", "USD 12.30", types.USD, types.LocaleEnUS, 1230},
+		{"en-US accounting", "($0.05)", types.USD, types.LocaleEnUS, -5},
+		{"JPY", "¥1,234", "JPY", types.LocaleEnUS, 1234},
+		{"KWD", "1.5", "KWD", types.LocaleEnUS, 1500},
+		{"min int64", "-92,233,720,368,547,758.08", types.USD, types.LocaleEnUS, math.MinInt64},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := types.ParseMoney(tc.input, tc.currency, tc.locale)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, m.Amount())
+			assert.Equal(t, tc.currency, m.Currency())
+		})
+	}
+}
+
+func TestParseMoney_Errors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		input      string
+		currency   types.Currency
+		locale     types.Locale
+		constraint string
+	}{
+		{"empty", "  ", types.BRL, types.LocalePtBR, "required"},
+		{"symbol only", "R$", types.BRL, types.LocalePtBR, "required"},
+		{"too many decimals", "1,234", types.BRL, types.LocalePtBR, "decimal_places"},
+		{"decimals for JPY", "1.5", "JPY", types.LocaleEnUS, "decimal_places"},
+		{"dangling decimal separator", "10.", types.USD, types.LocaleEnUS, "decimal_places"},
+		{"wrong locale style", "1.234.56", types.USD, types.LocaleEnUS, "format"},
+		{"bad grouping", "12,34.00", types.USD, types.LocaleEnUS, "format"},
+		{"letters", "abc", types.USD, types.LocaleEnUS, "format"},
+		{"out of range", "92,233,720,368,547,758.08", types.USD, types.LocaleEnUS, "range"},
+		{"unsupported locale", "1", types.USD, types.Locale("de-DE"), "locale"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.ParseMoney(tc.input, tc.currency, tc.locale)
+			msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+			assert.Equal(t, tc.constraint, msgErr.Context[msg.KeyConstraint])
+			assert.Equal(t, tc.input, msgErr.Context[msg.KeyInput])
+			assert.Equal(t, tc.locale.String(), msgErr.Context[msg.KeyLocale])
+		})
+	}
+
+	_, err := types.ParseMoney("1", types.Currency("ABC"), types.LocaleEnUS)
+	requireMsgErrorCode(t, err, msg.CodeInvalid)
+}
+
+func TestMoney_FormatParseRoundTrip(t *testing.T) {
+	for _, locale := range []types.Locale{types.LocalePtBR, types.LocaleEnUS} {
+		for _, amount := range []int64{0, 1, -99, 100000, 987654321} {
+			m := types.MustNewMoney(amount, types.BRL)
+			formatted, err := m.Format(locale)
+			require.NoError(t, err)
+			parsed, err := types.ParseMoney(formatted, types.BRL, locale)
+			require.NoError(t, err)
+			assert.Equal(t, m, parsed, "round trip of %s", formatted)
+		}
+	}
+}
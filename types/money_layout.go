@@ -0,0 +1,247 @@
+package types
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+// MoneyLayout identifica como um valor monetário está gravado no banco.
+type MoneyLayout string
+
+const (
+	// MoneyLayoutColumns usa duas colunas: amount (unidades mínimas) e currency.
+	MoneyLayoutColumns MoneyLayout = "columns"
+	// MoneyLayoutText usa uma coluna texto no formato "BRL 1234.56".
+	MoneyLayoutText MoneyLayout = "text"
+	// MoneyLayoutJSON usa o objeto {"amount":123456,"currency":"BRL"}, o formato nativo de Money.
+	MoneyLayoutJSON MoneyLayout = "json"
+	// MoneyLayoutNumeric usa uma coluna NUMERIC com a moeda implícita definida pelo adaptador.
+	MoneyLayoutNumeric MoneyLayout = "numeric"
+)
+
+// Decimal retorna o valor em unidades maiores com a escala da moeda, ex.: 1234.56.
+func (m Money) Decimal() Decimal {
+	return Decimal{unscaled: big.NewInt(m.amount), scale: m.currency.MinorUnits()}
+}
+
+// NewMoneyFromDecimal converte para unidades mínimas sem arredondar; casas decimais
+// além das da moeda são rejeitadas.
+func NewMoneyFromDecimal(d Decimal, currency Currency) (Money, error) {
+	if err := validateMoneyCurrency(currency); err != nil {
+		return Money{}, err
+	}
+	minorUnits := currency.MinorUnits()
+	if d.scale > minorUnits {
+		trimmed, err := d.Round(minorUnits, RoundDown)
+		if err != nil || !trimmed.Equals(d) {
+			message := fmt.Sprintf("Money value '%s' must have at most %d decimal places for %s.", d, minorUnits, currency)
+			return Money{}, msg.NewValidationError(nil,
+				msg.ValidationContext{Field: "money", Input: d.String(), Constraint: "decimal_places", Limit: minorUnits}.ContextMap(),
+				message,
+			)
+		}
+		d = trimmed
+	}
+	amount := d.rescaled(minorUnits)
+	if !amount.IsInt64() {
+		message := fmt.Sprintf("Money value '%s' is out of range.", d)
+		return Money{}, msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "money", Input: d.String(), Constraint: "range"}.ContextMap(),
+			message,
+		)
+	}
+	return Money{amount: amount.Int64(), currency: currency}, nil
+}
+
+func rawScanValue(src interface{}) string {
+	switch v := src.(type) {
+	case []byte:
+		return string(v)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func moneyLayoutError(layout MoneyLayout, src interface{}, cause error, reason string) error {
+	raw := rawScanValue(src)
+	payload := msg.ConversionContext{TargetType: "Money", Source: msg.SourceDB, Input: raw}
+	if src != nil {
+		payload.ReceivedType = fmt.Sprintf("%T", src)
+	}
+	message := fmt.Sprintf("Failed to scan Money from %s layout (raw value: '%s'): %s", layout, raw, reason)
+	return msg.NewValidationError(cause,
+		payload.ContextMap(),
+		message,
+	).WithContext(msg.KeyLayout, string(layout))
+}
+
+func scanText(src interface{}) (string, bool) {
+	switch v := src.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	default:
+		return "", false
+	}
+}
+
+// MoneyText adapta Money para uma coluna texto "BRL 1234.56".
+type MoneyText struct {
+	Money *Money
+}
+
+func AsMoneyText(m *Money) MoneyText {
+	return MoneyText{Money: m}
+}
+
+func (a MoneyText) Value() (driver.Value, error) {
+	return fmt.Sprintf("%s %s", a.Money.currency, a.Money.Decimal()), nil
+}
+
+func (a MoneyText) Scan(src interface{}) error {
+	raw, ok := scanText(src)
+	if !ok {
+		return moneyLayoutError(MoneyLayoutText, src, nil, "expected string or []byte.")
+	}
+	code, amount, found := strings.Cut(strings.TrimSpace(raw), " ")
+	if !found {
+		return moneyLayoutError(MoneyLayoutText, src, nil, "expected '<currency> <amount>'.")
+	}
+	currency, err := NewCurrency(code)
+	if err != nil {
+		return moneyLayoutError(MoneyLayoutText, src, err, "invalid currency.")
+	}
+	d, err := NewDecimal(amount)
+	if err != nil {
+		return moneyLayoutError(MoneyLayoutText, src, err, "invalid amount.")
+	}
+	m, err := NewMoneyFromDecimal(d, currency)
+	if err != nil {
+		return moneyLayoutError(MoneyLayoutText, src, err, "amount does not fit the currency.")
+	}
+	*a.Money = m
+	return nil
+}
+
+// MoneyNumeric adapta Money para uma coluna NUMERIC cuja moeda é conhecida pela aplicação.
+type MoneyNumeric struct {
+	Money    *Money
+	Currency Currency
+}
+
+func AsMoneyNumeric(m *Money, currency Currency) MoneyNumeric {
+	return MoneyNumeric{Money: m, Currency: currency}
+}
+
+func (a MoneyNumeric) Value() (driver.Value, error) {
+	if a.Money.currency != a.Currency {
+		return nil, Money{currency: a.Currency}.ensureSameCurrency(*a.Money)
+	}
+	return a.Money.Decimal().String(), nil
+}
+
+func (a MoneyNumeric) Scan(src interface{}) error {
+	if src == nil {
+		return moneyLayoutError(MoneyLayoutNumeric, src, nil, "scanned nil value for non-nullable Money.")
+	}
+	var d Decimal
+	if err := d.Scan(src); err != nil {
+		return moneyLayoutError(MoneyLayoutNumeric, src, err, "invalid NUMERIC value.")
+	}
+	m, err := NewMoneyFromDecimal(d, a.Currency)
+	if err != nil {
+		return moneyLayoutError(MoneyLayoutNumeric, src, err, "amount does not fit the currency.")
+	}
+	*a.Money = m
+	return nil
+}
+
+// MoneyJSON adapta Money para uma coluna JSON/JSONB explicitamente.
+type MoneyJSON struct {
+	Money *Money
+}
+
+func AsMoneyJSON(m *Money) MoneyJSON {
+	return MoneyJSON{Money: m}
+}
+
+func (a MoneyJSON) Value() (driver.Value, error) {
+	return a.Money.Value()
+}
+
+func (a MoneyJSON) Scan(src interface{}) error {
+	if err := a.Money.Scan(src); err != nil {
+		return moneyLayoutError(MoneyLayoutJSON, src, err, "invalid JSON Money object.")
+	}
+	return nil
+}
+
+// MoneyAmountColumn e MoneyCurrencyColumn preenchem o mesmo Money a partir de
+// duas colunas, em qualquer ordem.
+type MoneyAmountColumn struct {
+	Money *Money
+}
+
+type MoneyCurrencyColumn struct {
+	Money *Money
+}
+
+// AsMoneyColumns retorna os adaptadores de amount e currency:
+//
+//	amount, currency := types.AsMoneyColumns(&price)
+//	err := row.Scan(&id, amount, currency)
+func AsMoneyColumns(m *Money) (MoneyAmountColumn, MoneyCurrencyColumn) {
+	return MoneyAmountColumn{Money: m}, MoneyCurrencyColumn{Money: m}
+}
+
+func (a MoneyAmountColumn) Value() (driver.Value, error) {
+	return a.Money.amount, nil
+}
+
+func (a MoneyAmountColumn) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case int64:
+		a.Money.amount = v
+		return nil
+	case string, []byte:
+		raw, _ := scanText(v)
+		amount, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+		if err != nil {
+			return moneyLayoutError(MoneyLayoutColumns, src, err, "amount must be an integer in minor units.")
+		}
+		a.Money.amount = amount
+		return nil
+	case nil:
+		return moneyLayoutError(MoneyLayoutColumns, src, nil, "scanned nil amount for non-nullable Money.")
+	default:
+		return moneyLayoutError(MoneyLayoutColumns, src, nil, "amount must be an integer in minor units.")
+	}
+}
+
+func (a MoneyCurrencyColumn) Value() (driver.Value, error) {
+	return a.Money.currency.String(), nil
+}
+
+func (a MoneyCurrencyColumn) Scan(src interface{}) error {
+	raw, ok := scanText(src)
+	if !ok {
+		return moneyLayoutError(MoneyLayoutColumns, src, nil, "currency must be a string.")
+	}
+	currency, err := NewCurrency(raw)
+	if err == nil {
+		err = validateMoneyCurrency(currency)
+	}
+	if err != nil {
+		return moneyLayoutError(MoneyLayoutColumns, src, err, "invalid currency.")
+	}
+	a.Money.currency = currency
+	return nil
+}
@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func requireLayoutError(t *testing.T, err error, layout types.MoneyLayout, raw string) *msg.MessageError {
+	t.Helper()
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, string(layout), msgErr.Context[msg.KeyLayout])
+	assert.Equal(t, raw, msgErr.Context[msg.KeyInput])
+	assert.Equal(t, "Money", msgErr.Context[msg.KeyTargetType])
+	assert.Contains(t, msgErr.Message, string(layout))
+	return msgErr
+}
+
+func TestMoney_Decimal(t *testing.T) {
+	assert.Equal(t, "1234.56", types.MustNewMoney(123456, types.BRL).Decimal().String())
+	assert.Equal(t, "-0.05", types.MustNewMoney(-5, types.BRL).Decimal().String())
+
+	m, err := types.NewMoneyFromDecimal(types.MustNewDecimal("1234.5"), types.BRL)
+	require.NoError(t, err)
+	assert.Equal(t, int64(123450), m.Amount())
+
+	m, err = types.NewMoneyFromDecimal(types.MustNewDecimal("10.500"), types.BRL)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1050), m.Amount())
+
+	_, err = types.NewMoneyFromDecimal(types.MustNewDecimal("10.505"), types.BRL)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "decimal_places", msgErr.Context[msg.KeyConstraint])
+
+	_, err = types.NewMoneyFromDecimal(types.MustNewDecimal("100000000000000000000"), types.BRL)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "range", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestMoneyText(t *testing.T) {
+	price := types.MustNewMoney(123456, types.BRL)
+	value, err := types.AsMoneyText(&price).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "BRL 1234.56", value)
+
+	var scanned types.Money
+	require.NoError(t, types.AsMoneyText(&scanned).Scan([]byte("usd 10.5")))
+	assert.Equal(t, types.MustNewMoney(1050, types.USD), scanned)
+
+	testCases := []struct {
+		name string
+		src  interface{}
+		raw  string
+	}{
+		{"missing separator", "BRL1234.56", "BRL1234.56"},
+		{"invalid currency", "ABC 1.00", "ABC 1.00"},
+		{"invalid amount", "BRL 1,00", "BRL 1,00"},
+		{"too many decimals", "BRL 1.001", "BRL 1.001"},
+		{"unsupported type", int64(10), "10"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requireLayoutError(t, types.AsMoneyText(&scanned).Scan(tc.src), types.MoneyLayoutText, tc.raw)
+		})
+	}
+}
+
+func TestMoneyNumeric(t *testing.T) {
+	price := types.MustNewMoney(999, types.BRL)
+	value, err := types.AsMoneyNumeric(&price, types.BRL).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "9.99", value)
+
+	_, err = types.AsMoneyNumeric(&price, types.USD).Value()
+	requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+
+	var scanned types.Money
+	adapter := types.AsMoneyNumeric(&scanned, types.BRL)
+	require.NoError(t, adapter.Scan([]byte("1234.50")))
+	assert.Equal(t, types.MustNewMoney(123450, types.BRL), scanned)
+
+	require.NoError(t, adapter.Scan(int64(7)))
+	assert.Equal(t, int64(700), scanned.Amount())
+
+	requireLayoutError(t, adapter.Scan("12.345"), types.MoneyLayoutNumeric, "12.345")
+	requireLayoutError(t, adapter.Scan("abc"), types.MoneyLayoutNumeric, "abc")
+	requireLayoutError(t, adapter.Scan(nil), types.MoneyLayoutNumeric, "")
+}
+
+func TestMoneyJSON(t *testing.T) {
+	price := types.MustNewMoney(123456, types.BRL)
+	value, err := types.AsMoneyJSON(&price).Value()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":123456,"currency":"BRL"}`, value.(string))
+
+	var scanned types.Money
+	require.NoError(t, types.AsMoneyJSON(&scanned).Scan([]byte(value.(string))))
+	assert.Equal(t, price, scanned)
+
+	requireLayoutError(t, types.AsMoneyJSON(&scanned).Scan("BRL 1.00"), types.MoneyLayoutJSON, "BRL 1.00")
+}
+
+func TestMoneyColumns(t *testing.T) {
+	price := types.MustNewMoney(123456, types.BRL)
+	amount, currency := types.AsMoneyColumns(&price)
+	amountValue, err := amount.Value()
+	require.NoError(t, err)
+	currencyValue, err := currency.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456), amountValue)
+	assert.Equal(t, "BRL", currencyValue)
+
+	var scanned types.Money
+	amount, currency = types.AsMoneyColumns(&scanned)
+	require.NoError(t, currency.Scan([]byte("usd")))
+	require.NoError(t, amount.Scan(int64(1050)))
+	assert.Equal(t, types.MustNewMoney(1050, types.USD), scanned)
+
+	require.NoError(t, amount.Scan([]byte("-25")))
+	assert.Equal(t, int64(-25), scanned.Amount())
+
+	requireLayoutError(t, amount.Scan("10.50"), types.MoneyLayoutColumns, "10.50")
+	requireLayoutError(t, amount.Scan(nil), types.MoneyLayoutColumns, "")
+	requireLayoutError(t, currency.Scan("ABC"), types.MoneyLayoutColumns, "ABC")
+	requireLayoutError(t, currency.Scan(int64(986)), types.MoneyLayoutColumns, "986")
+}
@@ -0,0 +1,153 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestNewMoney(t *testing.T) {
+	m, err := types.NewMoney(1234, types.BRL)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234), m.Amount())
+	assert.Equal(t, types.BRL, m.Currency())
+	assert.Equal(t, "1234 BRL", m.String())
+
+	_, err = types.NewMoney(1, types.Currency("ABC"))
+	requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.ErrorIs(t, err, types.ErrInvalidCurrency)
+
+	zero, err := types.NewZeroMoney(types.USD)
+	require.NoError(t, err)
+	assert.True(t, zero.IsZero())
+
+	assert.Panics(t, func() { types.MustNewMoney(1, "") })
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	a := types.MustNewMoney(1000, types.BRL)
+	b := types.MustNewMoney(250, types.BRL)
+	usd := types.MustNewMoney(250, types.USD)
+
+	t.Run("Add", func(t *testing.T) {
+		sum, err := a.Add(b)
+		require.NoError(t, err)
+		assert.Equal(t, types.MustNewMoney(1250, types.BRL), sum)
+	})
+
+	t.Run("Subtract", func(t *testing.T) {
+		diff, err := b.Subtract(a)
+		require.NoError(t, err)
+		assert.Equal(t, int64(-750), diff.Amount())
+		assert.True(t, diff.IsNegative())
+	})
+
+	t.Run("Multiply", func(t *testing.T) {
+		product, err := b.Multiply(3)
+		require.NoError(t, err)
+		assert.Equal(t, int64(750), product.Amount())
+	})
+
+	t.Run("Negate and Abs", func(t *testing.T) {
+		neg, err := a.Negate()
+		require.NoError(t, err)
+		assert.Equal(t, int64(-1000), neg.Amount())
+		abs, err := neg.Abs()
+		require.NoError(t, err)
+		assert.Equal(t, a, abs)
+	})
+
+	t.Run("refuses mixed currencies", func(t *testing.T) {
+		_, err := a.Add(usd)
+		msgErr := requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+		assert.Equal(t, "same_currency", msgErr.Context[msg.KeyConstraint])
+
+		_, err = a.Subtract(usd)
+		requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+		_, err = a.Compare(usd)
+		requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+	})
+
+	t.Run("detects overflow", func(t *testing.T) {
+		max := types.MustNewMoney(math.MaxInt64, types.BRL)
+		min := types.MustNewMoney(math.MinInt64, types.BRL)
+		one := types.MustNewMoney(1, types.BRL)
+
+		_, err := max.Add(one)
+		msgErr := requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+		assert.Equal(t, "add", msgErr.Context[msg.KeyOperation])
+
+		_, err = min.Subtract(one)
+		requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+		_, err = one.Subtract(min)
+		requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+		_, err = max.Multiply(2)
+		requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+		_, err = min.Multiply(-1)
+		requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+		_, err = min.Negate()
+		requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+	})
+}
+
+func TestMoney_Comparisons(t *testing.T) {
+	a := types.MustNewMoney(100, types.BRL)
+	b := types.MustNewMoney(200, types.BRL)
+
+	cmp, err := a.Compare(b)
+	require.NoError(t, err)
+	assert.Equal(t, -1, cmp)
+
+	gt, err := b.GreaterThan(a)
+	require.NoError(t, err)
+	assert.True(t, gt)
+
+	lt, err := b.LessThan(a)
+	require.NoError(t, err)
+	assert.False(t, lt)
+
+	assert.True(t, a.Equals(types.MustNewMoney(100, types.BRL)))
+	assert.False(t, a.Equals(types.MustNewMoney(100, types.USD)))
+	assert.True(t, a.IsPositive())
+}
+
+func TestMoney_JSON(t *testing.T) {
+	m := types.MustNewMoney(1234, types.BRL)
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":1234,"currency":"BRL"}`, string(data))
+
+	var decoded types.Money
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, m, decoded)
+
+	err = json.Unmarshal([]byte(`{"amount":12.5,"currency":"BRL"}`), &decoded)
+	requireMsgErrorCode(t, err, msg.CodeInvalid)
+
+	err = json.Unmarshal([]byte(`{"amount":1,"currency":"ABC"}`), &decoded)
+	requireMsgErrorCode(t, err, msg.CodeInvalid)
+}
+
+func TestMoney_SQL(t *testing.T) {
+	m := types.MustNewMoney(-50, types.USD)
+
+	value, err := m.Value()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":-50,"currency":"USD"}`, value.(string))
+
+	var scanned types.Money
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, m, scanned)
+
+	requireMsgErrorCode(t, scanned.Scan(nil), msg.CodeInvalid)
+	requireMsgErrorCode(t, scanned.Scan(int64(1)), msg.CodeInvalid)
+	msgErr := requireMsgErrorCode(t, scanned.Scan("not json"), msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+}
@@ -60,7 +60,7 @@ func (nt *NullableTime) UnmarshalJSON(data []byte) error {
 		nt.Valid = false
 		message := fmt.Sprintf("NullableTime must be a valid JSON timestamp or 'null'; received '%s'.", string(data))
 		return msg.NewValidationError(err,
-			map[string]any{"input_json": string(data), "target_type": "NullableTime"},
+			msg.ConversionContext{TargetType: "NullableTime", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
 			message,
 		)
 	}
@@ -82,7 +82,11 @@ type DeletedAt struct {
 }
 
 func NewDeletedAtNow() DeletedAt {
-	return DeletedAt{NullableTime: NewNullableTime(time.Now(), true)}
+	return NewDeletedAtFrom(DefaultClock())
+}
+
+func NewDeletedAtFrom(c Clock) DeletedAt {
+	return DeletedAt{NullableTime: NewNullableTime(c.Now(), true)}
 }
 
 func NewNilDeletedAt() DeletedAt {
@@ -90,7 +94,11 @@ func NewNilDeletedAt() DeletedAt {
 }
 
 func (da *DeletedAt) SetNow() {
-	da.Time = time.Now()
+	da.SetNowFrom(DefaultClock())
+}
+
+func (da *DeletedAt) SetNowFrom(c Clock) {
+	da.Time = c.Now()
 	da.Valid = true
 }
 
@@ -107,7 +115,11 @@ type ArchivedAt struct {
 }
 
 func NewArchivedAtNow() ArchivedAt {
-	return ArchivedAt{NullableTime: NewNullableTime(time.Now(), true)}
+	return NewArchivedAtFrom(DefaultClock())
+}
+
+func NewArchivedAtFrom(c Clock) ArchivedAt {
+	return ArchivedAt{NullableTime: NewNullableTime(c.Now(), true)}
 }
 
 func NewNilArchivedAt() ArchivedAt {
@@ -115,7 +127,11 @@ func NewNilArchivedAt() ArchivedAt {
 }
 
 func (aa *ArchivedAt) SetNow() {
-	aa.Time = time.Now()
+	aa.SetNowFrom(DefaultClock())
+}
+
+func (aa *ArchivedAt) SetNowFrom(c Clock) {
+	aa.Time = c.Now()
 	aa.Valid = true
 }
 
@@ -54,7 +54,7 @@ func (nu *NullableUUID) UnmarshalJSON(data []byte) error {
 		nu.Valid = false
 		message := fmt.Sprintf("NullableUUID must be a valid JSON UUID string or 'null'; received '%s'.", string(data))
 		return msg.NewValidationError(err,
-			map[string]any{"input_json": string(data), "target_type": "NullableUUID"},
+			msg.ConversionContext{TargetType: "NullableUUID", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
 			message,
 		)
 	}
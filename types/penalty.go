@@ -0,0 +1,174 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+type InterestPeriod string
+
+const (
+	InterestDaily   InterestPeriod = "daily"
+	InterestMonthly InterestPeriod = "monthly"
+)
+
+// DaysPerInterestMonth é a convenção usada no pró-rata de juros mensais.
+const DaysPerInterestMonth = 30
+
+type PenaltyRequest struct {
+	Amount Money
+	// DueDate é o vencimento; quando zero, é resolvido a partir de BillingDay e PaymentDate.
+	DueDate     time.Time
+	BillingDay  Day
+	PaymentDate time.Time
+	// FineRate é a multa fixa em fração decimal ("0.02" para 2%).
+	FineRate string
+	// InterestRate é a taxa de juros de mora em fração decimal no período InterestPeriod.
+	InterestRate   string
+	InterestPeriod InterestPeriod
+	// CorrectionRate é a variação acumulada do índice de correção monetária ("0.0123"); opcional.
+	CorrectionRate string
+	// GraceDays é a carência: atrasos até esse número de dias não geram multa nem juros.
+	GraceDays int
+	Rounding  RoundingMode
+}
+
+type PenaltyResult struct {
+	DueDate     time.Time
+	PaymentDate time.Time
+	DaysOverdue int
+	WithinGrace bool
+	Principal   Money
+	Correction  Money
+	Fine        Money
+	Interest    Money
+	Total       Money
+}
+
+func penaltyError(input any, constraint, message string, limit any) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "penalty", Input: input, Constraint: constraint, Limit: limit}.ContextMap(),
+		message,
+	)
+}
+
+// parsePenaltyRate aceita taxa vazia como zero.
+func parsePenaltyRate(value, name string) (*big.Rat, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return new(big.Rat), nil
+	}
+	r, ok := new(big.Rat).SetString(trimmed)
+	if !ok || r.Sign() < 0 {
+		message := fmt.Sprintf("Penalty %s '%s' must be a non-negative decimal fraction.", strings.ReplaceAll(name, "_", " "), value)
+		return nil, penaltyError(value, name, message, nil)
+	}
+	return r, nil
+}
+
+// civilDaysBetween conta dias de calendário entre as datas, ignorando horário e fuso.
+func civilDaysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// CalculatePenalty calcula correção, multa e juros de mora simples pró-rata dia.
+// Multa e juros incidem sobre o valor corrigido e os juros contam desde o vencimento.
+func CalculatePenalty(req PenaltyRequest) (PenaltyResult, error) {
+	if err := validateMoneyCurrency(req.Amount.currency); err != nil {
+		return PenaltyResult{}, err
+	}
+	if req.Amount.amount < 0 {
+		return PenaltyResult{}, penaltyError(req.Amount.amount, "non_negative_amount", "Penalty amount cannot be negative.", 0)
+	}
+	if req.PaymentDate.IsZero() {
+		return PenaltyResult{}, penaltyError(nil, "payment_date", "Penalty payment date is required.", nil)
+	}
+	if req.GraceDays < 0 {
+		return PenaltyResult{}, penaltyError(req.GraceDays, "grace_days", "Penalty grace days cannot be negative.", 0)
+	}
+	if req.InterestPeriod != InterestDaily && req.InterestPeriod != InterestMonthly {
+		message := fmt.Sprintf("Interest period '%s' is not valid.", req.InterestPeriod)
+		return PenaltyResult{}, penaltyError(string(req.InterestPeriod), "interest_period", message, []InterestPeriod{InterestDaily, InterestMonthly})
+	}
+	rounding := req.Rounding
+	if rounding == "" {
+		rounding = RoundHalfEven
+	}
+	if !rounding.IsValid() {
+		return PenaltyResult{}, roundingModeError(rounding)
+	}
+
+	fineRate, err := parsePenaltyRate(req.FineRate, "fine_rate")
+	if err != nil {
+		return PenaltyResult{}, err
+	}
+	interestRate, err := parsePenaltyRate(req.InterestRate, "interest_rate")
+	if err != nil {
+		return PenaltyResult{}, err
+	}
+	correctionRate, err := parsePenaltyRate(req.CorrectionRate, "correction_rate")
+	if err != nil {
+		return PenaltyResult{}, err
+	}
+
+	dueDate := req.DueDate
+	var daysOverdue int
+	switch {
+	case !dueDate.IsZero():
+		daysOverdue = civilDaysBetween(dueDate, req.PaymentDate)
+		if daysOverdue < 0 {
+			daysOverdue = 0
+		}
+	case req.BillingDay >= 1 && req.BillingDay <= 31:
+		daysOverdue = req.BillingDay.DaysOverdue(req.PaymentDate)
+		dueDate = req.PaymentDate.AddDate(0, 0, -daysOverdue)
+	default:
+		return PenaltyResult{}, penaltyError(nil, "due_date", "Penalty requires a due date or a valid billing day.", nil)
+	}
+
+	result := PenaltyResult{
+		DueDate:     dueDate,
+		PaymentDate: req.PaymentDate,
+		DaysOverdue: daysOverdue,
+		WithinGrace: daysOverdue <= req.GraceDays,
+		Principal:   req.Amount,
+		Correction:  Money{currency: req.Amount.currency},
+		Fine:        Money{currency: req.Amount.currency},
+		Interest:    Money{currency: req.Amount.currency},
+		Total:       req.Amount,
+	}
+	if daysOverdue == 0 || result.WithinGrace {
+		return result, nil
+	}
+
+	principal := ratInt64(req.Amount.amount)
+	correction := roundRat(new(big.Rat).Mul(principal, correctionRate), rounding)
+	corrected := new(big.Rat).Add(principal, new(big.Rat).SetInt(correction))
+
+	fine := roundRat(new(big.Rat).Mul(corrected, fineRate), rounding)
+
+	interest := new(big.Rat).Mul(corrected, interestRate)
+	interest.Mul(interest, ratInt64(int64(daysOverdue)))
+	if req.InterestPeriod == InterestMonthly {
+		interest.Quo(interest, ratInt64(DaysPerInterestMonth))
+	}
+	interestAmount := roundRat(interest, rounding)
+
+	total := new(big.Int).SetInt64(req.Amount.amount)
+	total.Add(total, correction).Add(total, fine).Add(total, interestAmount)
+	if !total.IsInt64() {
+		return PenaltyResult{}, moneyOverflowError("penalty", req.Amount.amount, 0)
+	}
+
+	result.Correction.amount = correction.Int64()
+	result.Fine.amount = fine.Int64()
+	result.Interest.amount = interestAmount.Int64()
+	result.Total.amount = total.Int64()
+	return result, nil
+}
@@ -0,0 +1,169 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestCalculatePenalty_MonthlyInterestProRata(t *testing.T) {
+	result, err := types.CalculatePenalty(types.PenaltyRequest{
+		Amount:         types.MustNewMoney(100000, types.BRL),
+		DueDate:        time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC),
+		PaymentDate:    time.Date(2026, time.October, 25, 14, 30, 0, 0, time.UTC),
+		FineRate:       "0.02",
+		InterestRate:   "0.01",
+		InterestPeriod: types.InterestMonthly,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 15, result.DaysOverdue)
+	assert.False(t, result.WithinGrace)
+	assert.Equal(t, int64(100000), result.Principal.Amount())
+	assert.True(t, result.Correction.IsZero())
+	assert.Equal(t, int64(2000), result.Fine.Amount())
+	assert.Equal(t, int64(500), result.Interest.Amount())
+	assert.Equal(t, int64(102500), result.Total.Amount())
+}
+
+func TestCalculatePenalty_DailyInterest(t *testing.T) {
+	result, err := types.CalculatePenalty(types.PenaltyRequest{
+		Amount:         types.MustNewMoney(100000, types.BRL),
+		DueDate:        time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC),
+		PaymentDate:    time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC),
+		InterestRate:   "0.00033",
+		InterestPeriod: types.InterestDaily,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, result.Fine.IsZero())
+	assert.Equal(t, int64(495), result.Interest.Amount())
+	assert.Equal(t, int64(100495), result.Total.Amount())
+}
+
+func TestCalculatePenalty_MonetaryCorrection(t *testing.T) {
+	result, err := types.CalculatePenalty(types.PenaltyRequest{
+		Amount:         types.MustNewMoney(100000, types.BRL),
+		DueDate:        time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC),
+		PaymentDate:    time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC),
+		FineRate:       "0.02",
+		InterestRate:   "0.01",
+		InterestPeriod: types.InterestMonthly,
+		CorrectionRate: "0.01",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1000), result.Correction.Amount())
+	assert.Equal(t, int64(2020), result.Fine.Amount())
+	assert.Equal(t, int64(505), result.Interest.Amount())
+	assert.Equal(t, int64(103525), result.Total.Amount())
+}
+
+func TestCalculatePenalty_GracePeriod(t *testing.T) {
+	req := types.PenaltyRequest{
+		Amount:         types.MustNewMoney(100000, types.BRL),
+		DueDate:        time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC),
+		PaymentDate:    time.Date(2026, time.October, 15, 0, 0, 0, 0, time.UTC),
+		FineRate:       "0.02",
+		InterestRate:   "0.01",
+		InterestPeriod: types.InterestMonthly,
+		GraceDays:      5,
+	}
+	result, err := types.CalculatePenalty(req)
+	require.NoError(t, err)
+	assert.True(t, result.WithinGrace)
+	assert.Equal(t, 5, result.DaysOverdue)
+	assert.Equal(t, int64(100000), result.Total.Amount())
+
+	req.PaymentDate = req.PaymentDate.AddDate(0, 0, 1)
+	result, err = types.CalculatePenalty(req)
+	require.NoError(t, err)
+	assert.False(t, result.WithinGrace)
+	assert.Equal(t, int64(2000), result.Fine.Amount())
+	assert.Equal(t, int64(200), result.Interest.Amount(), "interest counts from the due date")
+}
+
+func TestCalculatePenalty_PaidOnTime(t *testing.T) {
+	result, err := types.CalculatePenalty(types.PenaltyRequest{
+		Amount:         types.MustNewMoney(100000, types.BRL),
+		DueDate:        time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC),
+		PaymentDate:    time.Date(2026, time.October, 8, 0, 0, 0, 0, time.UTC),
+		FineRate:       "0.02",
+		InterestPeriod: types.InterestMonthly,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.DaysOverdue)
+	assert.Equal(t, int64(100000), result.Total.Amount())
+}
+
+func TestCalculatePenalty_BillingDay(t *testing.T) {
+	result, err := types.CalculatePenalty(types.PenaltyRequest{
+		Amount:         types.MustNewMoney(100000, types.BRL),
+		BillingDay:     types.Day(10),
+		PaymentDate:    time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC),
+		FineRate:       "0.02",
+		InterestRate:   "0.01",
+		InterestPeriod: types.InterestMonthly,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 15, result.DaysOverdue)
+	assert.Equal(t, time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC), result.DueDate)
+	assert.Equal(t, int64(102500), result.Total.Amount())
+}
+
+func TestCalculatePenalty_Rounding(t *testing.T) {
+	req := types.PenaltyRequest{
+		Amount:         types.MustNewMoney(333, types.BRL),
+		DueDate:        time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC),
+		PaymentDate:    time.Date(2026, time.October, 11, 0, 0, 0, 0, time.UTC),
+		FineRate:       "0.02",
+		InterestPeriod: types.InterestDaily,
+	}
+	result, err := types.CalculatePenalty(req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), result.Fine.Amount())
+
+	req.Rounding = types.RoundDown
+	result, err = types.CalculatePenalty(req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), result.Fine.Amount())
+}
+
+func TestCalculatePenalty_Errors(t *testing.T) {
+	valid := types.PenaltyRequest{
+		Amount:         types.MustNewMoney(100000, types.BRL),
+		DueDate:        time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC),
+		PaymentDate:    time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC),
+		InterestPeriod: types.InterestMonthly,
+	}
+	testCases := []struct {
+		name       string
+		mutate     func(r *types.PenaltyRequest)
+		constraint string
+	}{
+		{"negative amount", func(r *types.PenaltyRequest) { r.Amount = types.MustNewMoney(-1, types.BRL) }, "non_negative_amount"},
+		{"missing payment date", func(r *types.PenaltyRequest) { r.PaymentDate = time.Time{} }, "payment_date"},
+		{"missing due date", func(r *types.PenaltyRequest) { r.DueDate = time.Time{} }, "due_date"},
+		{"negative grace", func(r *types.PenaltyRequest) { r.GraceDays = -1 }, "grace_days"},
+		{"invalid period", func(r *types.PenaltyRequest) { r.InterestPeriod = "weekly" }, "interest_period"},
+		{"invalid fine", func(r *types.PenaltyRequest) { r.FineRate = "2%" }, "fine_rate"},
+		{"negative interest", func(r *types.PenaltyRequest) { r.InterestRate = "-0.01" }, "interest_rate"},
+		{"invalid correction", func(r *types.PenaltyRequest) { r.CorrectionRate = "abc" }, "correction_rate"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := valid
+			tc.mutate(&req)
+			_, err := types.CalculatePenalty(req)
+			msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+			assert.Equal(t, "penalty", msgErr.Context[msg.KeyField])
+			assert.Equal(t, tc.constraint, msgErr.Context[msg.KeyConstraint])
+		})
+	}
+}
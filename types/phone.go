@@ -36,9 +36,9 @@ func validateAndPrefixNormalizedPhone(normalizedNum string, originalInputForErro
 		if strings.HasPrefix(finalNum, DefaultCountryCode) {
 			message := fmt.Sprintf("Invalid phone number format: 11-digit number starting with country code '%s' is ambiguous or incomplete.", DefaultCountryCode)
 			return "", msg.NewValidationError(nil,
-				map[string]any{"input_phone": originalInputForErrorContext, "normalized_phone": finalNum},
+				msg.ValidationContext{Field: "phone", Input: originalInputForErrorContext, Constraint: "format"}.ContextMap(),
 				message,
-			)
+			).WithContext(msg.KeyNormalized, finalNum)
 		}
 		finalNum = DefaultCountryCode + finalNum
 		numLen = len(finalNum)
@@ -47,35 +47,41 @@ func validateAndPrefixNormalizedPhone(normalizedNum string, originalInputForErro
 	if numLen != NormalizedPhoneLength {
 		message := fmt.Sprintf("Normalized phone number must have %d digits (e.g., 55DDNNNNNNNNN), got %d.", NormalizedPhoneLength, numLen)
 		return "", msg.NewValidationError(nil,
-			map[string]any{"input_phone": originalInputForErrorContext, "normalized_phone_after_prefix_attempt": finalNum, "expected_length": NormalizedPhoneLength, "actual_length": numLen},
+			msg.ValidationContext{Field: "phone", Input: originalInputForErrorContext, Constraint: "length", Limit: NormalizedPhoneLength}.ContextMap(),
 			message,
-		)
+		).WithContext(msg.KeyNormalized, finalNum).WithContext(msg.KeyLength, numLen)
 	}
 
 	if !strings.HasPrefix(finalNum, DefaultCountryCode) {
 		message := fmt.Sprintf("Normalized 13-digit phone number must start with country code '%s'.", DefaultCountryCode)
 		return "", msg.NewValidationError(nil,
-			map[string]any{"input_phone": originalInputForErrorContext, "normalized_phone": finalNum, "expected_prefix": DefaultCountryCode},
+			msg.ValidationContext{Field: "phone", Input: originalInputForErrorContext, Constraint: "country_code", Limit: DefaultCountryCode}.ContextMap(),
 			message,
-		)
+		).WithContext(msg.KeyNormalized, finalNum)
 	}
 
 	return finalNum, nil
 }
 
 func NewPhone(phoneStr string) (Phone, error) {
+	phone, _, err := NewPhoneWithWarnings(phoneStr)
+	return phone, err
+}
+
+// NewPhoneWithWarnings cria o Phone e avisa quando o DefaultCountryCode foi adicionado.
+func NewPhoneWithWarnings(phoneStr string) (Phone, msg.Warnings, error) {
 	trimmedInput := strings.TrimSpace(phoneStr)
 	if trimmedInput == "" {
-		return "", msg.NewValidationError(nil,
-			map[string]any{"input_phone": phoneStr},
+		return "", nil, msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "phone", Input: phoneStr, Constraint: "required"}.ContextMap(),
 			"Phone number cannot be empty.",
 		)
 	}
 
 	if utf8.RuneCountInString(trimmedInput) > MaxRawPhoneInputLength {
 		message := fmt.Sprintf("Raw phone input (length %d) exceeds maximum length of %d characters.", utf8.RuneCountInString(trimmedInput), MaxRawPhoneInputLength)
-		return "", msg.NewValidationError(nil,
-			map[string]any{"max_length": MaxRawPhoneInputLength, "input_phone": phoneStr},
+		return "", nil, msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "phone", Input: phoneStr, Constraint: "max_length", Limit: MaxRawPhoneInputLength}.ContextMap(),
 			message,
 		)
 	}
@@ -83,10 +89,19 @@ func NewPhone(phoneStr string) (Phone, error) {
 	normalized := normalizePhone(trimmedInput)
 	validatedNum, err := validateAndPrefixNormalizedPhone(normalized, phoneStr)
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+
+	var warnings msg.Warnings
+	if len(normalized) == DDDLength+LocalPhoneNumberLength {
+		message := fmt.Sprintf("Phone number '%s' was normalized by adding country code '%s'.", phoneStr, DefaultCountryCode)
+		warnings.Add(msg.NewNormalizedWarning(
+			msg.ValidationContext{Field: "phone", Input: phoneStr, Constraint: "country_code", Limit: DefaultCountryCode}.ContextMap(),
+			message,
+		).WithContext(msg.KeyNormalized, validatedNum))
 	}
 
-	return Phone(validatedNum), nil
+	return Phone(validatedNum), warnings, nil
 }
 
 func MustNewPhone(phoneStr string) Phone {
@@ -114,7 +129,7 @@ func (p *Phone) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &s); err != nil {
 		message := fmt.Sprintf("Phone must be a valid JSON string (received: %s).", string(data))
 		return msg.NewValidationError(err,
-			map[string]any{"input_json": string(data)},
+			msg.ConversionContext{TargetType: "Phone", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
 			message,
 		)
 	}
@@ -141,7 +156,8 @@ func (p *Phone) UnmarshalText(text []byte) error {
 
 func (p Phone) Value() (driver.Value, error) {
 	if p.IsEmpty() {
-		return nil, msg.NewValidationError(nil, nil,
+		return nil, msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "Phone", Source: msg.SourceDB}.ContextMap(),
 			"Attempted to save an empty or invalid Phone value to the database.")
 	}
 	return p.String(), nil
@@ -150,7 +166,7 @@ func (p Phone) Value() (driver.Value, error) {
 func (p *Phone) Scan(src interface{}) error {
 	if src == nil {
 		return msg.NewValidationError(nil,
-			map[string]any{"target_type": "Phone"},
+			msg.ConversionContext{TargetType: "Phone", Source: msg.SourceDB}.ContextMap(),
 			"Scanned nil value for non-nullable Phone type from database.",
 		)
 	}
@@ -164,7 +180,7 @@ func (p *Phone) Scan(src interface{}) error {
 	default:
 		message := fmt.Sprintf("Incompatible type (%T) for Phone scan. Expected string or []byte.", src)
 		return msg.NewValidationError(nil,
-			map[string]any{"received_type": fmt.Sprintf("%T", src)},
+			msg.ConversionContext{TargetType: "Phone", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
 			message,
 		)
 	}
@@ -173,12 +189,12 @@ func (p *Phone) Scan(src interface{}) error {
 	validatedNum, err := validateAndPrefixNormalizedPhone(normalizedFromDB, phoneStr)
 	if err != nil {
 		if originalMsgErr, ok := err.(*msg.MessageError); ok {
-			originalMsgErr.WithContext("scan_source_value_db", phoneStr)
+			originalMsgErr.WithContext(msg.KeySource, msg.SourceDB)
 			return originalMsgErr
 		}
 		message := fmt.Sprintf("Failed to scan database value ('%s') to Phone due to invalid format after normalization.", phoneStr)
 		return msg.NewValidationError(err,
-			map[string]any{"scan_source_value_db": phoneStr},
+			msg.ConversionContext{TargetType: "Phone", Source: msg.SourceDB, Input: phoneStr}.ContextMap(),
 			message,
 		)
 	}
@@ -315,3 +315,20 @@ func TestMustNewPhone(t *testing.T) {
 		types.MustNewPhone("invalid")
 	})
 }
+
+func TestNewPhoneWithWarnings(t *testing.T) {
+	t.Run("warns when country code is added", func(t *testing.T) {
+		phone, warnings, err := types.NewPhoneWithWarnings("(11) 98765-4321")
+		require.NoError(t, err)
+		assert.Equal(t, types.Phone("5511987654321"), phone)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, msg.CodeNormalized, warnings[0].Code)
+		assert.Equal(t, "5511987654321", warnings[0].Context[msg.KeyNormalized])
+	})
+
+	t.Run("no warnings for full number", func(t *testing.T) {
+		_, warnings, err := types.NewPhoneWithWarnings("5511987654321")
+		require.NoError(t, err)
+		assert.True(t, warnings.IsEmpty())
+	})
+}
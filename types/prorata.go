@@ -0,0 +1,202 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+type DayCountConvention string
+
+const (
+	// DayCountActual usa os dias corridos do ciclo, de 28 a 31.
+	DayCountActual DayCountConvention = "actual"
+	// DayCount30360 considera ciclos de 30 dias e conta os dias usados pela regra 30E/360.
+	DayCount30360 DayCountConvention = "30/360"
+)
+
+type ProRataLineKind string
+
+const (
+	// ProRataUsed é o valor consumido do plano atual, do início do ciclo até a mudança.
+	ProRataUsed ProRataLineKind = "used"
+	// ProRataCredit é o valor não utilizado do plano atual, devolvido ao cliente.
+	ProRataCredit ProRataLineKind = "credit"
+	// ProRataCharge é o valor do novo plano pelos dias restantes do ciclo.
+	ProRataCharge ProRataLineKind = "charge"
+)
+
+type ProRataRequest struct {
+	// Amount é o valor do ciclo completo do plano atual.
+	Amount     Money
+	BillingDay Day
+	// ChangeDate é o dia em que a mudança passa a valer; ele já conta como dia restante.
+	ChangeDate Date
+	// NewAmount é o valor do ciclo completo do novo plano; zero em cancelamentos.
+	NewAmount Money
+	// Convention é a contagem de dias; o padrão é DayCountActual.
+	Convention DayCountConvention
+	// MonthEndPolicy resolve o dia de cobrança em meses curtos; o padrão é MonthEndClamp.
+	MonthEndPolicy MonthEndPolicy
+	Rounding       RoundingMode
+}
+
+// ProRataLine é um item do demonstrativo: Amount = Base × Days / PeriodDays.
+type ProRataLine struct {
+	Kind        ProRataLineKind `json:"kind"`
+	Base        Money           `json:"base"`
+	Days        int             `json:"days"`
+	PeriodDays  int             `json:"period_days"`
+	Amount      Money           `json:"amount"`
+	Description string          `json:"description"`
+}
+
+type ProRataResult struct {
+	// Period é o ciclo de cobrança que contém ChangeDate, de um vencimento ao próximo.
+	Period        DateRange
+	Convention    DayCountConvention
+	Rounding      RoundingMode
+	PeriodDays    int
+	UsedDays      int
+	RemainingDays int
+	Used          Money
+	Credit        Money
+	Charge        Money
+	// Net é Charge - Credit; negativo indica valor a devolver.
+	Net   Money
+	Lines []ProRataLine
+}
+
+func proRataError(input any, constraint, message string, limit any) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "prorata", Input: input, Constraint: constraint, Limit: limit}.ContextMap(),
+		message,
+	)
+}
+
+// billingPeriod retorna o ciclo [vencimento anterior ou igual a d, vencimento seguinte).
+func billingPeriod(day Day, d Date, policy MonthEndPolicy) (DateRange, error) {
+	start, err := day.PreviousDueDate(d.In(time.UTC), policy)
+	if err != nil {
+		return DateRange{}, err
+	}
+	end, err := day.NextDueDate(start.AddDays(1).In(time.UTC), policy)
+	if err != nil {
+		return DateRange{}, err
+	}
+	return NewDateRange(start, end)
+}
+
+// days30E360 conta dias pela convenção 30E/360: dias 31 valem 30.
+func days30E360(from, to Date) int {
+	d1, d2 := min(from.day, 30), min(to.day, 30)
+	return 360*(to.year-from.year) + 30*int(to.month-from.month) + d2 - d1
+}
+
+// usedDays30360 conta os dias usados em um ciclo de 30 dias. Um início limitado ao fim do
+// mês (dia 31 resolvido em 28/02) vale dia 30, como na regra de fim de fevereiro. Enquanto
+// houver dias corridos usados ou restantes, cada lado fica com pelo menos um dia, pois os
+// dias 30 e 31 se confundem na convenção.
+func usedDays30360(period DateRange, change Date, billingDay Day) int {
+	used := days30E360(period.start, change)
+	if period.start.IsLastDayOfMonth() && period.start.day < min(billingDay.Int(), 30) {
+		used -= 30 - period.start.day
+	}
+	actualUsed := period.start.DaysUntil(change)
+	actualRemaining := period.Days() - actualUsed
+	used = max(used, min(actualUsed, 1))
+	return min(used, 30-min(actualRemaining, 1))
+}
+
+func proRate(base Money, days, periodDays int, mode RoundingMode) Money {
+	r := new(big.Rat).Mul(ratInt64(base.amount), big.NewRat(int64(days), int64(periodDays)))
+	return Money{amount: roundRat(r, mode).Int64(), currency: base.currency}
+}
+
+// CalculateProRata calcula o consumo, o crédito e a nova cobrança proporcionais de uma
+// mudança no meio do ciclo. O crédito é Amount - Used, então consumo e crédito sempre
+// somam o valor do ciclo e o arredondamento não cria nem perde centavos.
+func CalculateProRata(req ProRataRequest) (ProRataResult, error) {
+	if err := validateMoneyCurrency(req.Amount.currency); err != nil {
+		return ProRataResult{}, err
+	}
+	if req.NewAmount.currency == "" {
+		req.NewAmount.currency = req.Amount.currency
+	}
+	if err := req.Amount.ensureSameCurrency(req.NewAmount); err != nil {
+		return ProRataResult{}, err
+	}
+	if req.Amount.amount < 0 || req.NewAmount.amount < 0 {
+		return ProRataResult{}, proRataError(min(req.Amount.amount, req.NewAmount.amount), "non_negative_amount", "Pro-rata amounts cannot be negative.", 0)
+	}
+	if req.ChangeDate.IsZero() {
+		return ProRataResult{}, proRataError(nil, "change_date", "Pro-rata change date is required.", nil)
+	}
+	convention := req.Convention
+	if convention == "" {
+		convention = DayCountActual
+	}
+	if convention != DayCountActual && convention != DayCount30360 {
+		message := fmt.Sprintf("Day count convention '%s' is not valid.", convention)
+		return ProRataResult{}, proRataError(string(convention), "convention", message, []DayCountConvention{DayCountActual, DayCount30360})
+	}
+	policy := req.MonthEndPolicy
+	if policy == "" {
+		policy = MonthEndClamp
+	}
+	rounding := req.Rounding
+	if rounding == "" {
+		rounding = RoundHalfEven
+	}
+	if !rounding.IsValid() {
+		return ProRataResult{}, roundingModeError(rounding)
+	}
+
+	period, err := billingPeriod(req.BillingDay, req.ChangeDate, policy)
+	if err != nil {
+		return ProRataResult{}, err
+	}
+	periodDays := period.Days()
+	usedDays := period.start.DaysUntil(req.ChangeDate)
+	if convention == DayCount30360 {
+		periodDays = 30
+		usedDays = usedDays30360(period, req.ChangeDate, req.BillingDay)
+	}
+	remainingDays := periodDays - usedDays
+
+	used := proRate(req.Amount, usedDays, periodDays, rounding)
+	credit := Money{amount: req.Amount.amount - used.amount, currency: req.Amount.currency}
+	charge := proRate(req.NewAmount, remainingDays, periodDays, rounding)
+
+	result := ProRataResult{
+		Period:        period,
+		Convention:    convention,
+		Rounding:      rounding,
+		PeriodDays:    periodDays,
+		UsedDays:      usedDays,
+		RemainingDays: remainingDays,
+		Used:          used,
+		Credit:        credit,
+		Charge:        charge,
+		Net:           Money{amount: charge.amount - credit.amount, currency: req.Amount.currency},
+		Lines: []ProRataLine{
+			{
+				Kind: ProRataUsed, Base: req.Amount, Days: usedDays, PeriodDays: periodDays, Amount: used,
+				Description: fmt.Sprintf("Used %d of %d days of the current plan: %d x %d/%d = %d (%s).", usedDays, periodDays, req.Amount.amount, usedDays, periodDays, used.amount, rounding),
+			},
+			{
+				Kind: ProRataCredit, Base: req.Amount, Days: remainingDays, PeriodDays: periodDays, Amount: credit,
+				Description: fmt.Sprintf("Credit for %d unused days of the current plan: %d - %d = %d.", remainingDays, req.Amount.amount, used.amount, credit.amount),
+			},
+		},
+	}
+	if req.NewAmount.amount > 0 {
+		result.Lines = append(result.Lines, ProRataLine{
+			Kind: ProRataCharge, Base: req.NewAmount, Days: remainingDays, PeriodDays: periodDays, Amount: charge,
+			Description: fmt.Sprintf("Charge for %d remaining days of the new plan: %d x %d/%d = %d (%s).", remainingDays, req.NewAmount.amount, remainingDays, periodDays, charge.amount, rounding),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,193 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestCalculateProRata_Upgrade(t *testing.T) {
+	brl := func(amount int64) types.Money { return types.MustNewMoney(amount, types.BRL) }
+
+	testCases := []struct {
+		name          string
+		req           types.ProRataRequest
+		period        types.DateRange
+		periodDays    int
+		usedDays      int
+		used, credit  int64
+		charge, net   int64
+		expectedLines int
+	}{
+		{
+			name:       "actual days",
+			req:        types.ProRataRequest{Amount: brl(10000), NewAmount: brl(20000), BillingDay: 10, ChangeDate: date(2026, time.October, 22)},
+			period:     types.MustNewDateRange(date(2026, time.October, 10), date(2026, time.November, 10)),
+			periodDays: 31, usedDays: 12,
+			used: 3871, credit: 6129, charge: 12258, net: 6129,
+			expectedLines: 3,
+		},
+		{
+			name:       "30/360",
+			req:        types.ProRataRequest{Amount: brl(10000), NewAmount: brl(20000), BillingDay: 10, ChangeDate: date(2026, time.October, 22), Convention: types.DayCount30360},
+			period:     types.MustNewDateRange(date(2026, time.October, 10), date(2026, time.November, 10)),
+			periodDays: 30, usedDays: 12,
+			used: 4000, credit: 6000, charge: 12000, net: 6000,
+			expectedLines: 3,
+		},
+		{
+			name:       "billing day 31 in february",
+			req:        types.ProRataRequest{Amount: brl(10000), BillingDay: 31, ChangeDate: date(2026, time.February, 15)},
+			period:     types.MustNewDateRange(date(2026, time.January, 31), date(2026, time.February, 28)),
+			periodDays: 28, usedDays: 15,
+			used: 5357, credit: 4643, charge: 0, net: -4643,
+			expectedLines: 2,
+		},
+		{
+			name:       "billing day 31 in february with 30/360",
+			req:        types.ProRataRequest{Amount: brl(10000), BillingDay: 31, ChangeDate: date(2026, time.February, 15), Convention: types.DayCount30360},
+			period:     types.MustNewDateRange(date(2026, time.January, 31), date(2026, time.February, 28)),
+			periodDays: 30, usedDays: 15,
+			used: 5000, credit: 5000, charge: 0, net: -5000,
+			expectedLines: 2,
+		},
+		{
+			name:       "billing day 31 clamped in february with 30/360, first day of march",
+			req:        types.ProRataRequest{Amount: brl(10000), BillingDay: 31, ChangeDate: date(2026, time.March, 1), Convention: types.DayCount30360},
+			period:     types.MustNewDateRange(date(2026, time.February, 28), date(2026, time.March, 31)),
+			periodDays: 30, usedDays: 1,
+			used: 333, credit: 9667, charge: 0, net: -9667,
+			expectedLines: 2,
+		},
+		{
+			name:       "billing day 31 clamped in february with 30/360, day before due date",
+			req:        types.ProRataRequest{Amount: brl(10000), NewAmount: brl(20000), BillingDay: 31, ChangeDate: date(2026, time.March, 30), Convention: types.DayCount30360},
+			period:     types.MustNewDateRange(date(2026, time.February, 28), date(2026, time.March, 31)),
+			periodDays: 30, usedDays: 29,
+			used: 9667, credit: 333, charge: 667, net: 334,
+			expectedLines: 3,
+		},
+		{
+			name:       "billing day 30 clamped in february with 30/360",
+			req:        types.ProRataRequest{Amount: brl(10000), BillingDay: 30, ChangeDate: date(2026, time.March, 15), Convention: types.DayCount30360},
+			period:     types.MustNewDateRange(date(2026, time.February, 28), date(2026, time.March, 30)),
+			periodDays: 30, usedDays: 15,
+			used: 5000, credit: 5000, charge: 0, net: -5000,
+			expectedLines: 2,
+		},
+		{
+			name:       "change on the due date",
+			req:        types.ProRataRequest{Amount: brl(10000), NewAmount: brl(15000), BillingDay: 10, ChangeDate: date(2026, time.October, 10)},
+			period:     types.MustNewDateRange(date(2026, time.October, 10), date(2026, time.November, 10)),
+			periodDays: 31, usedDays: 0,
+			used: 0, credit: 10000, charge: 15000, net: 5000,
+			expectedLines: 3,
+		},
+		{
+			name:       "rounding down keeps used plus credit exact",
+			req:        types.ProRataRequest{Amount: brl(10000), BillingDay: 10, ChangeDate: date(2026, time.October, 22), Rounding: types.RoundDown},
+			period:     types.MustNewDateRange(date(2026, time.October, 10), date(2026, time.November, 10)),
+			periodDays: 31, usedDays: 12,
+			used: 3870, credit: 6130, charge: 0, net: -6130,
+			expectedLines: 2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := types.CalculateProRata(tc.req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.period, result.Period)
+			assert.Equal(t, tc.periodDays, result.PeriodDays)
+			assert.Equal(t, tc.usedDays, result.UsedDays)
+			assert.Equal(t, tc.periodDays-tc.usedDays, result.RemainingDays)
+			assert.Equal(t, brl(tc.used), result.Used)
+			assert.Equal(t, brl(tc.credit), result.Credit)
+			assert.Equal(t, brl(tc.charge), result.Charge)
+			assert.Equal(t, brl(tc.net), result.Net)
+			assert.Equal(t, tc.req.Amount.Amount(), result.Used.Amount()+result.Credit.Amount())
+			assert.Len(t, result.Lines, tc.expectedLines)
+		})
+	}
+}
+
+func TestCalculateProRata_DefaultsAndExplanation(t *testing.T) {
+	result, err := types.CalculateProRata(types.ProRataRequest{
+		Amount:     types.MustNewMoney(10000, types.BRL),
+		NewAmount:  types.MustNewMoney(20000, types.BRL),
+		BillingDay: 10,
+		ChangeDate: date(2026, time.October, 22),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.DayCountActual, result.Convention)
+	assert.Equal(t, types.RoundHalfEven, result.Rounding)
+
+	require.Len(t, result.Lines, 3)
+	assert.Equal(t, types.ProRataUsed, result.Lines[0].Kind)
+	assert.Equal(t, "Used 12 of 31 days of the current plan: 10000 x 12/31 = 3871 (half_even).", result.Lines[0].Description)
+	assert.Equal(t, types.ProRataCredit, result.Lines[1].Kind)
+	assert.Equal(t, 19, result.Lines[1].Days)
+	assert.Equal(t, "Credit for 19 unused days of the current plan: 10000 - 3871 = 6129.", result.Lines[1].Description)
+	assert.Equal(t, types.ProRataCharge, result.Lines[2].Kind)
+	assert.Equal(t, types.MustNewMoney(20000, types.BRL), result.Lines[2].Base)
+	assert.Equal(t, "Charge for 19 remaining days of the new plan: 20000 x 19/31 = 12258 (half_even).", result.Lines[2].Description)
+}
+
+func TestCalculateProRata_Rounding(t *testing.T) {
+	req := types.ProRataRequest{
+		Amount:     types.MustNewMoney(5, types.BRL),
+		BillingDay: 1,
+		ChangeDate: date(2026, time.October, 16),
+		Convention: types.DayCount30360,
+	}
+
+	halfEven, err := types.CalculateProRata(req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), halfEven.Used.Amount(), "2.5 rounds to even")
+	assert.Equal(t, int64(3), halfEven.Credit.Amount())
+
+	req.Rounding = types.RoundHalfUp
+	halfUp, err := types.CalculateProRata(req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), halfUp.Used.Amount())
+	assert.Equal(t, int64(2), halfUp.Credit.Amount())
+}
+
+func TestCalculateProRata_Errors(t *testing.T) {
+	valid := types.ProRataRequest{
+		Amount:     types.MustNewMoney(10000, types.BRL),
+		BillingDay: 10,
+		ChangeDate: date(2026, time.October, 22),
+	}
+
+	testCases := []struct {
+		name       string
+		mutate     func(*types.ProRataRequest)
+		code       msg.ErrorCode
+		field      string
+		constraint string
+	}{
+		{"negative amount", func(r *types.ProRataRequest) { r.Amount = types.MustNewMoney(-1, types.BRL) }, msg.CodeInvalid, "prorata", "non_negative_amount"},
+		{"negative new amount", func(r *types.ProRataRequest) { r.NewAmount = types.MustNewMoney(-1, types.BRL) }, msg.CodeInvalid, "prorata", "non_negative_amount"},
+		{"currency mismatch", func(r *types.ProRataRequest) { r.NewAmount = types.MustNewMoney(100, types.USD) }, msg.CodeDomainViolation, "money", "same_currency"},
+		{"missing change date", func(r *types.ProRataRequest) { r.ChangeDate = types.Date{} }, msg.CodeInvalid, "prorata", "change_date"},
+		{"invalid convention", func(r *types.ProRataRequest) { r.Convention = "30/365" }, msg.CodeInvalid, "prorata", "convention"},
+		{"invalid rounding", func(r *types.ProRataRequest) { r.Rounding = "nearest" }, msg.CodeInvalid, "rounding", "enum"},
+		{"invalid billing day", func(r *types.ProRataRequest) { r.BillingDay = 0 }, msg.CodeInvalid, "day", "range"},
+		{"invalid month-end policy", func(r *types.ProRataRequest) { r.BillingDay = 31; r.MonthEndPolicy = "last" }, msg.CodeInvalid, "month_end_policy", "enum"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := valid
+			tc.mutate(&req)
+			_, err := types.CalculateProRata(req)
+			msgErr := requireMsgErrorCode(t, err, tc.code)
+			assert.Equal(t, tc.field, msgErr.Context[msg.KeyField])
+			assert.Equal(t, tc.constraint, msgErr.Context[msg.KeyConstraint])
+		})
+	}
+}
@@ -0,0 +1,464 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+func rangeError(field string, input any, constraint, message string) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: field, Input: input, Constraint: constraint}.ContextMap(),
+		message,
+	)
+}
+
+// parseRangeText separa um range no formato texto do PostgreSQL, ex.: "[2026-01-01,2026-02-01)",
+// em limites sem aspas. Ranges vazios ou ilimitados não são aceitos.
+func parseRangeText(field, value string) (lower, upper string, lowerInclusive, upperInclusive bool, err error) {
+	s := strings.TrimSpace(value)
+	formatErr := func() error {
+		message := fmt.Sprintf("Invalid range '%s'. Expected '[start,end)' with both bounds.", value)
+		return rangeError(field, value, "format", message)
+	}
+	if len(s) < 2 || !strings.ContainsRune("[(", rune(s[0])) || !strings.ContainsRune("])", rune(s[len(s)-1])) {
+		return "", "", false, false, formatErr()
+	}
+	lower, upper, found := strings.Cut(s[1:len(s)-1], ",")
+	lower = strings.Trim(strings.TrimSpace(lower), `"`)
+	upper = strings.Trim(strings.TrimSpace(upper), `"`)
+	if !found || lower == "" || upper == "" || strings.Contains(upper, ",") {
+		return "", "", false, false, formatErr()
+	}
+	return lower, upper, s[0] == '[', s[len(s)-1] == ']', nil
+}
+
+// TimeRange é um intervalo semiaberto [start, end) de instantes, equivalente ao
+// tstzrange do PostgreSQL. O valor zero representa intervalo ausente.
+type TimeRange struct {
+	start time.Time
+	end   time.Time
+}
+
+func NewTimeRange(start, end time.Time) (TimeRange, error) {
+	if start.IsZero() || end.IsZero() {
+		return TimeRange{}, rangeError("time_range", nil, "required", "Time range requires both start and end.")
+	}
+	if !start.Before(end) {
+		message := fmt.Sprintf("Time range start %s must be before end %s.", start.Format(time.RFC3339Nano), end.Format(time.RFC3339Nano))
+		return TimeRange{}, rangeError("time_range", start.Format(time.RFC3339Nano), "order", message)
+	}
+	return TimeRange{start: start, end: end}, nil
+}
+
+func MustNewTimeRange(start, end time.Time) TimeRange {
+	r, err := NewTimeRange(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ParseTimeRange aceita o formato do tstzrange, ex.: ["2026-01-01 00:00:00-03","2026-02-01 00:00:00-03").
+// Apenas limites [start, end) são aceitos, pois instantes não têm sucessor discreto.
+func ParseTimeRange(value string) (TimeRange, error) {
+	lower, upper, lowerInclusive, upperInclusive, err := parseRangeText("time_range", value)
+	if err != nil {
+		return TimeRange{}, err
+	}
+	if !lowerInclusive || upperInclusive {
+		message := fmt.Sprintf("Time range '%s' must include its start and exclude its end.", value)
+		return TimeRange{}, rangeError("time_range", value, "format", message)
+	}
+	start, err := parseAuditTimeMultipleLayouts(lower)
+	var end time.Time
+	if err == nil {
+		end, err = parseAuditTimeMultipleLayouts(upper)
+	}
+	if err != nil {
+		message := fmt.Sprintf("Invalid time range bounds in '%s'.", value)
+		return TimeRange{}, msg.NewValidationError(err,
+			msg.ValidationContext{Field: "time_range", Input: value, Constraint: "format"}.ContextMap(),
+			message,
+		)
+	}
+	return NewTimeRange(start, end)
+}
+
+func (r TimeRange) Start() time.Time { return r.start }
+func (r TimeRange) End() time.Time   { return r.end }
+func (r TimeRange) IsZero() bool     { return r.start.IsZero() && r.end.IsZero() }
+
+func (r TimeRange) Duration() time.Duration {
+	return r.end.Sub(r.start)
+}
+
+func (r TimeRange) Equal(other TimeRange) bool {
+	return r.start.Equal(other.start) && r.end.Equal(other.end)
+}
+
+// Contains informa se start <= t < end.
+func (r TimeRange) Contains(t time.Time) bool {
+	return !t.Before(r.start) && t.Before(r.end)
+}
+
+// Overlaps informa se os intervalos têm algum instante em comum; intervalos adjacentes não se sobrepõem.
+func (r TimeRange) Overlaps(other TimeRange) bool {
+	return r.start.Before(other.end) && other.start.Before(r.end)
+}
+
+// Intersect retorna o trecho comum aos dois intervalos; ok é false se não se sobrepõem.
+func (r TimeRange) Intersect(other TimeRange) (TimeRange, bool) {
+	start, end := r.start, r.end
+	if other.start.After(start) {
+		start = other.start
+	}
+	if other.end.Before(end) {
+		end = other.end
+	}
+	if !start.Before(end) {
+		return TimeRange{}, false
+	}
+	return TimeRange{start: start, end: end}, true
+}
+
+// Gap retorna o intervalo entre os dois; ok é false se eles se sobrepõem ou são adjacentes.
+func (r TimeRange) Gap(other TimeRange) (TimeRange, bool) {
+	first, second := r, other
+	if second.start.Before(first.start) {
+		first, second = second, first
+	}
+	if !first.end.Before(second.start) {
+		return TimeRange{}, false
+	}
+	return TimeRange{start: first.end, end: second.start}, true
+}
+
+// SplitByMonth divide o intervalo nas viradas de mês do fuso informado; nil usa o fuso de start.
+func (r TimeRange) SplitByMonth(loc *time.Location) []TimeRange {
+	if r.IsZero() {
+		return nil
+	}
+	if loc == nil {
+		loc = r.start.Location()
+	}
+	var parts []TimeRange
+	start := r.start
+	for start.Before(r.end) {
+		local := start.In(loc)
+		boundary := time.Date(local.Year(), local.Month()+1, 1, 0, 0, 0, 0, loc)
+		end := r.end
+		if boundary.Before(end) {
+			end = boundary
+		}
+		parts = append(parts, TimeRange{start: start, end: end})
+		start = end
+	}
+	return parts
+}
+
+// String retorna o formato texto do tstzrange.
+func (r TimeRange) String() string {
+	if r.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf(`["%s","%s")`, r.start.Format(LAYOUT_DB_WITH_OFFSET_COLON_AUDIT), r.end.Format(LAYOUT_DB_WITH_OFFSET_COLON_AUDIT))
+}
+
+type timeRangeJSON struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+func (r TimeRange) MarshalJSON() ([]byte, error) {
+	if r.IsZero() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(timeRangeJSON{Start: r.start, End: r.end})
+}
+
+// UnmarshalJSON aceita {"start":...,"end":...}, o texto do tstzrange ou null.
+func (r *TimeRange) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = TimeRange{}
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		parsed, err := ParseTimeRange(text)
+		if err != nil {
+			return err
+		}
+		*r = parsed
+		return nil
+	}
+	var raw timeRangeJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		message := fmt.Sprintf("TimeRange must be a JSON object with start and end, a range string or 'null'; received '%s'.", string(data))
+		return msg.NewValidationError(err,
+			msg.ConversionContext{TargetType: "TimeRange", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
+			message,
+		)
+	}
+	parsed, err := NewTimeRange(raw.Start, raw.End)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// Value grava o intervalo no formato texto aceito por colunas tstzrange.
+func (r TimeRange) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+func (r *TimeRange) Scan(src interface{}) error {
+	var raw string
+	switch v := src.(type) {
+	case nil:
+		*r = TimeRange{}
+		return nil
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		message := fmt.Sprintf("Incompatible type (%T) for TimeRange. Expected string or []byte.", src)
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "TimeRange", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
+	}
+	parsed, err := ParseTimeRange(raw)
+	if err != nil {
+		return scanErrorFromDB(err)
+	}
+	*r = parsed
+	return nil
+}
+
+func scanErrorFromDB(err error) error {
+	if msgErr, ok := err.(*msg.MessageError); ok {
+		msgErr.WithContext(msg.KeySource, msg.SourceDB)
+		return msgErr
+	}
+	return err
+}
+
+// DateRange é um intervalo semiaberto [start, end) de datas civis, equivalente ao
+// daterange do PostgreSQL. O valor zero representa intervalo ausente.
+type DateRange struct {
+	start Date
+	end   Date
+}
+
+func NewDateRange(start, end Date) (DateRange, error) {
+	if start.IsZero() || end.IsZero() {
+		return DateRange{}, rangeError("date_range", nil, "required", "Date range requires both start and end.")
+	}
+	if !start.Before(end) {
+		message := fmt.Sprintf("Date range start %s must be before end %s.", start, end)
+		return DateRange{}, rangeError("date_range", start.String(), "order", message)
+	}
+	return DateRange{start: start, end: end}, nil
+}
+
+func MustNewDateRange(start, end Date) DateRange {
+	r, err := NewDateRange(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// NewDateRangeInclusive cria o intervalo de first até last, inclusive.
+func NewDateRangeInclusive(first, last Date) (DateRange, error) {
+	if last.IsZero() {
+		return NewDateRange(first, last)
+	}
+	return NewDateRange(first, last.AddDays(1))
+}
+
+// ParseDateRange aceita o formato do daterange, ex.: "[2026-01-01,2026-02-01)". Limites
+// "(" e "]" são normalizados para [start, end), como faz o PostgreSQL.
+func ParseDateRange(value string) (DateRange, error) {
+	lower, upper, lowerInclusive, upperInclusive, err := parseRangeText("date_range", value)
+	if err != nil {
+		return DateRange{}, err
+	}
+	start, err := ParseDate(lower)
+	if err != nil {
+		return DateRange{}, err
+	}
+	end, err := ParseDate(upper)
+	if err != nil {
+		return DateRange{}, err
+	}
+	if !lowerInclusive {
+		start = start.AddDays(1)
+	}
+	if upperInclusive {
+		end = end.AddDays(1)
+	}
+	return NewDateRange(start, end)
+}
+
+func (r DateRange) Start() Date  { return r.start }
+func (r DateRange) End() Date    { return r.end }
+func (r DateRange) IsZero() bool { return r == DateRange{} }
+
+// Last retorna o último dia contido no intervalo.
+func (r DateRange) Last() Date {
+	if r.IsZero() {
+		return Date{}
+	}
+	return r.end.AddDays(-1)
+}
+
+// Days retorna o número de dias do intervalo.
+func (r DateRange) Days() int {
+	return r.start.DaysUntil(r.end)
+}
+
+func (r DateRange) Contains(d Date) bool {
+	return !d.Before(r.start) && d.Before(r.end)
+}
+
+// Overlaps informa se os intervalos têm algum dia em comum; intervalos adjacentes não se sobrepõem.
+func (r DateRange) Overlaps(other DateRange) bool {
+	return r.start.Before(other.end) && other.start.Before(r.end)
+}
+
+// Intersect retorna os dias comuns aos dois intervalos; ok é false se não se sobrepõem.
+func (r DateRange) Intersect(other DateRange) (DateRange, bool) {
+	start, end := r.start, r.end
+	if other.start.After(start) {
+		start = other.start
+	}
+	if other.end.Before(end) {
+		end = other.end
+	}
+	if !start.Before(end) {
+		return DateRange{}, false
+	}
+	return DateRange{start: start, end: end}, true
+}
+
+// Gap retorna os dias entre os dois intervalos; ok é false se eles se sobrepõem ou são adjacentes.
+func (r DateRange) Gap(other DateRange) (DateRange, bool) {
+	first, second := r, other
+	if second.start.Before(first.start) {
+		first, second = second, first
+	}
+	if !first.end.Before(second.start) {
+		return DateRange{}, false
+	}
+	return DateRange{start: first.end, end: second.start}, true
+}
+
+// SplitByMonth divide o intervalo por competência, ex.: [15/01, 10/03) gera
+// [15/01, 01/02), [01/02, 01/03) e [01/03, 10/03).
+func (r DateRange) SplitByMonth() []DateRange {
+	var parts []DateRange
+	for start := r.start; start.Before(r.end); {
+		end := start.YearMonth().Next().FirstDay()
+		if r.end.Before(end) {
+			end = r.end
+		}
+		parts = append(parts, DateRange{start: start, end: end})
+		start = end
+	}
+	return parts
+}
+
+// String retorna o formato texto do daterange.
+func (r DateRange) String() string {
+	if r.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("[%s,%s)", r.start, r.end)
+}
+
+type dateRangeJSON struct {
+	Start Date `json:"start"`
+	End   Date `json:"end"`
+}
+
+func (r DateRange) MarshalJSON() ([]byte, error) {
+	if r.IsZero() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(dateRangeJSON{Start: r.start, End: r.end})
+}
+
+// UnmarshalJSON aceita {"start":...,"end":...}, o texto do daterange ou null.
+func (r *DateRange) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = DateRange{}
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		parsed, err := ParseDateRange(text)
+		if err != nil {
+			return err
+		}
+		*r = parsed
+		return nil
+	}
+	var raw dateRangeJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		message := fmt.Sprintf("DateRange must be a JSON object with start and end, a range string or 'null'; received '%s'.", string(data))
+		return msg.NewValidationError(err,
+			msg.ConversionContext{TargetType: "DateRange", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
+			message,
+		)
+	}
+	parsed, err := NewDateRange(raw.Start, raw.End)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// Value grava o intervalo no formato texto aceito por colunas daterange.
+func (r DateRange) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+func (r *DateRange) Scan(src interface{}) error {
+	var raw string
+	switch v := src.(type) {
+	case nil:
+		*r = DateRange{}
+		return nil
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		message := fmt.Sprintf("Incompatible type (%T) for DateRange. Expected string or []byte.", src)
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "DateRange", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
+	}
+	parsed, err := ParseDateRange(raw)
+	if err != nil {
+		return scanErrorFromDB(err)
+	}
+	*r = parsed
+	return nil
+}
@@ -0,0 +1,262 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func utc(year int, month time.Month, day, hour int) time.Time {
+	return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestNewTimeRange(t *testing.T) {
+	r, err := types.NewTimeRange(utc(2026, time.January, 1, 0), utc(2026, time.January, 2, 12))
+	require.NoError(t, err)
+	assert.Equal(t, 36*time.Hour, r.Duration())
+	assert.False(t, r.IsZero())
+
+	_, err = types.NewTimeRange(utc(2026, time.January, 2, 0), utc(2026, time.January, 2, 0))
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "time_range", msgErr.Context[msg.KeyField])
+	assert.Equal(t, "order", msgErr.Context[msg.KeyConstraint])
+
+	_, err = types.NewTimeRange(time.Time{}, utc(2026, time.January, 2, 0))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "required", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestTimeRange_Relations(t *testing.T) {
+	jan := types.MustNewTimeRange(utc(2026, time.January, 1, 0), utc(2026, time.February, 1, 0))
+	feb := types.MustNewTimeRange(utc(2026, time.February, 1, 0), utc(2026, time.March, 1, 0))
+	mid := types.MustNewTimeRange(utc(2026, time.January, 20, 0), utc(2026, time.February, 10, 0))
+	apr := types.MustNewTimeRange(utc(2026, time.April, 1, 0), utc(2026, time.May, 1, 0))
+
+	assert.True(t, jan.Contains(utc(2026, time.January, 1, 0)))
+	assert.False(t, jan.Contains(utc(2026, time.February, 1, 0)))
+
+	assert.False(t, jan.Overlaps(feb), "adjacent ranges do not overlap")
+	assert.True(t, jan.Overlaps(mid))
+	assert.True(t, mid.Overlaps(feb))
+
+	intersection, ok := jan.Intersect(mid)
+	require.True(t, ok)
+	assert.True(t, intersection.Equal(types.MustNewTimeRange(utc(2026, time.January, 20, 0), utc(2026, time.February, 1, 0))))
+	_, ok = jan.Intersect(feb)
+	assert.False(t, ok)
+
+	gap, ok := apr.Gap(jan)
+	require.True(t, ok)
+	assert.True(t, gap.Equal(types.MustNewTimeRange(utc(2026, time.February, 1, 0), utc(2026, time.April, 1, 0))))
+	_, ok = jan.Gap(feb)
+	assert.False(t, ok)
+	_, ok = jan.Gap(mid)
+	assert.False(t, ok)
+}
+
+func TestTimeRange_SplitByMonth(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	r := types.MustNewTimeRange(utc(2026, time.January, 15, 12), utc(2026, time.March, 1, 1))
+	parts := r.SplitByMonth(time.UTC)
+	require.Len(t, parts, 3)
+	assert.Equal(t, utc(2026, time.February, 1, 0), parts[0].End())
+	assert.Equal(t, utc(2026, time.March, 1, 0), parts[1].End())
+	assert.Equal(t, utc(2026, time.March, 1, 1), parts[2].End())
+
+	// 01/03 01:00 UTC ainda é fevereiro em São Paulo (UTC-3).
+	parts = r.SplitByMonth(saoPaulo)
+	require.Len(t, parts, 2)
+	assert.True(t, parts[0].End().Equal(time.Date(2026, time.February, 1, 0, 0, 0, 0, saoPaulo)))
+	assert.True(t, parts[1].End().Equal(utc(2026, time.March, 1, 1)))
+}
+
+func TestParseTimeRange(t *testing.T) {
+	r, err := types.ParseTimeRange(`["2026-01-01 00:00:00-03","2026-02-01 00:00:00-03")`)
+	require.NoError(t, err)
+	assert.True(t, r.Start().Equal(utc(2026, time.January, 1, 3)))
+	assert.True(t, r.End().Equal(utc(2026, time.February, 1, 3)))
+
+	r, err = types.ParseTimeRange(r.String())
+	require.NoError(t, err)
+	assert.True(t, r.Start().Equal(utc(2026, time.January, 1, 3)))
+
+	for _, input := range []string{
+		"empty",
+		`["2026-01-01 00:00:00+00",)`,
+		`("2026-01-01 00:00:00+00","2026-02-01 00:00:00+00")`,
+		`["2026-01-01 00:00:00+00","2026-02-01 00:00:00+00"]`,
+		`["yesterday","2026-02-01 00:00:00+00")`,
+	} {
+		_, err := types.ParseTimeRange(input)
+		msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+		assert.Equal(t, "format", msgErr.Context[msg.KeyConstraint], input)
+	}
+}
+
+func TestTimeRange_JSONAndSQL(t *testing.T) {
+	r := types.MustNewTimeRange(utc(2026, time.January, 1, 0), utc(2026, time.February, 1, 0))
+
+	data, err := json.Marshal(r)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"start":"2026-01-01T00:00:00Z","end":"2026-02-01T00:00:00Z"}`, string(data))
+
+	var decoded types.TimeRange
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, r.Equal(decoded))
+	require.NoError(t, json.Unmarshal([]byte(`"[\"2026-01-01 00:00:00+00\",\"2026-02-01 00:00:00+00\")"`), &decoded))
+	assert.True(t, r.Equal(decoded))
+	require.NoError(t, json.Unmarshal([]byte(`null`), &decoded))
+	assert.True(t, decoded.IsZero())
+
+	err = json.Unmarshal([]byte(`42`), &decoded)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "TimeRange", msgErr.Context[msg.KeyTargetType])
+	err = json.Unmarshal([]byte(`{"start":"2026-02-01T00:00:00Z","end":"2026-01-01T00:00:00Z"}`), &decoded)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "order", msgErr.Context[msg.KeyConstraint])
+
+	value, err := r.Value()
+	require.NoError(t, err)
+	assert.Equal(t, `["2026-01-01 00:00:00+00:00","2026-02-01 00:00:00+00:00")`, value)
+
+	var scanned types.TimeRange
+	require.NoError(t, scanned.Scan([]byte(`["2026-01-01 00:00:00+00","2026-02-01 00:00:00+00")`)))
+	assert.True(t, r.Equal(scanned))
+	require.NoError(t, scanned.Scan(nil))
+	assert.True(t, scanned.IsZero())
+
+	value, err = scanned.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	err = scanned.Scan("empty")
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+	err = scanned.Scan(42)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "int", msgErr.Context[msg.KeyReceivedType])
+}
+
+func TestNewDateRange(t *testing.T) {
+	r, err := types.NewDateRange(date(2026, time.January, 1), date(2026, time.February, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 31, r.Days())
+	assert.Equal(t, date(2026, time.January, 31), r.Last())
+
+	inclusive, err := types.NewDateRangeInclusive(date(2026, time.January, 1), date(2026, time.January, 31))
+	require.NoError(t, err)
+	assert.Equal(t, r, inclusive)
+
+	_, err = types.NewDateRange(date(2026, time.February, 1), date(2026, time.January, 1))
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "date_range", msgErr.Context[msg.KeyField])
+	assert.Equal(t, "order", msgErr.Context[msg.KeyConstraint])
+
+	_, err = types.NewDateRange(date(2026, time.February, 1), types.Date{})
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "required", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestDateRange_Relations(t *testing.T) {
+	contract := types.MustNewDateRange(date(2026, time.January, 1), date(2026, time.July, 1))
+	promotion := types.MustNewDateRange(date(2026, time.June, 15), date(2026, time.August, 1))
+	renewal := types.MustNewDateRange(date(2026, time.September, 1), date(2027, time.January, 1))
+
+	assert.True(t, contract.Contains(date(2026, time.June, 30)))
+	assert.False(t, contract.Contains(date(2026, time.July, 1)))
+	assert.True(t, contract.Overlaps(promotion))
+	assert.False(t, contract.Overlaps(renewal))
+
+	intersection, ok := contract.Intersect(promotion)
+	require.True(t, ok)
+	assert.Equal(t, types.MustNewDateRange(date(2026, time.June, 15), date(2026, time.July, 1)), intersection)
+	assert.Equal(t, 16, intersection.Days())
+
+	gap, ok := contract.Gap(renewal)
+	require.True(t, ok)
+	assert.Equal(t, types.MustNewDateRange(date(2026, time.July, 1), date(2026, time.September, 1)), gap)
+	_, ok = contract.Gap(promotion)
+	assert.False(t, ok)
+}
+
+func TestDateRange_SplitByMonth(t *testing.T) {
+	r := types.MustNewDateRange(date(2026, time.January, 15), date(2026, time.March, 10))
+	assert.Equal(t, []types.DateRange{
+		types.MustNewDateRange(date(2026, time.January, 15), date(2026, time.February, 1)),
+		types.MustNewDateRange(date(2026, time.February, 1), date(2026, time.March, 1)),
+		types.MustNewDateRange(date(2026, time.March, 1), date(2026, time.March, 10)),
+	}, r.SplitByMonth())
+
+	assert.Nil(t, types.DateRange{}.SplitByMonth())
+}
+
+func TestParseDateRange(t *testing.T) {
+	expected := types.MustNewDateRange(date(2026, time.January, 1), date(2026, time.February, 1))
+	testCases := []string{
+		"[2026-01-01,2026-02-01)",
+		"[2026-01-01,2026-01-31]",
+		"(2025-12-31,2026-02-01)",
+		` ["2026-01-01","2026-02-01") `,
+	}
+	for _, input := range testCases {
+		r, err := types.ParseDateRange(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, r, input)
+	}
+
+	_, err := types.ParseDateRange("[2026-01-01,)")
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "date_range", msgErr.Context[msg.KeyField])
+	assert.Equal(t, "format", msgErr.Context[msg.KeyConstraint])
+
+	_, err = types.ParseDateRange("[2026-01-01,2026-02-30)")
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "date", msgErr.Context[msg.KeyField])
+}
+
+func TestDateRange_JSONAndSQL(t *testing.T) {
+	r := types.MustNewDateRange(date(2026, time.January, 1), date(2026, time.February, 1))
+
+	data, err := json.Marshal(r)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"start":"2026-01-01","end":"2026-02-01"}`, string(data))
+
+	var decoded types.DateRange
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, r, decoded)
+	require.NoError(t, json.Unmarshal([]byte(`"[2026-01-01,2026-02-01)"`), &decoded))
+	assert.Equal(t, r, decoded)
+
+	data, err = json.Marshal(types.DateRange{})
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	err = json.Unmarshal([]byte(`[1,2]`), &decoded)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "DateRange", msgErr.Context[msg.KeyTargetType])
+
+	value, err := r.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "[2026-01-01,2026-02-01)", value)
+
+	var scanned types.DateRange
+	require.NoError(t, scanned.Scan([]byte("[2026-01-01,2026-02-01)")))
+	assert.Equal(t, r, scanned)
+	require.NoError(t, scanned.Scan(nil))
+	assert.True(t, scanned.IsZero())
+
+	err = scanned.Scan("empty")
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+	err = scanned.Scan(time.Now())
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "time.Time", msgErr.Context[msg.KeyReceivedType])
+}
@@ -0,0 +1,446 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+var ErrIndexedRate = fmt.Errorf("rate depends on an unresolved index")
+
+type RatePeriod string
+
+const (
+	// RatePeriodDaily é a taxa ao dia corrido, com ano comercial de 360 dias.
+	RatePeriodDaily   RatePeriod = "daily"
+	RatePeriodMonthly RatePeriod = "monthly"
+	RatePeriodYearly  RatePeriod = "yearly"
+	// RatePeriodBusinessDay é a taxa ao dia útil, com ano de 252 dias úteis.
+	RatePeriodBusinessDay RatePeriod = "business_day_252"
+)
+
+// rateGuardDigits são dígitos extras calculados antes do arredondamento final de raízes.
+const rateGuardDigits = 10
+
+// rateBoundsScale é a escala usada ao converter limites para o período da taxa.
+const rateBoundsScale = 20
+
+func (p RatePeriod) IsValid() bool {
+	return p.years() != nil
+}
+
+// years retorna a fração de ano que o período representa.
+func (p RatePeriod) years() *big.Rat {
+	switch p {
+	case RatePeriodDaily:
+		return big.NewRat(1, 360)
+	case RatePeriodMonthly:
+		return big.NewRat(1, 12)
+	case RatePeriodYearly:
+		return big.NewRat(1, 1)
+	case RatePeriodBusinessDay:
+		return big.NewRat(1, 252)
+	default:
+		return nil
+	}
+}
+
+var rateSuffixes = map[Locale]map[RatePeriod]string{
+	LocalePtBR: {RatePeriodDaily: "a.d.", RatePeriodMonthly: "a.m.", RatePeriodYearly: "a.a.", RatePeriodBusinessDay: "a.d.u."},
+	LocaleEnUS: {RatePeriodDaily: "p.d.", RatePeriodMonthly: "p.m.", RatePeriodYearly: "p.a.", RatePeriodBusinessDay: "p.b.d."},
+}
+
+// Rate é uma taxa exata em fração decimal (0.0199 para 1,99%) associada a um período.
+// Uma taxa indexada representa "índice + spread", ex.: "CDI + 2% a.a.".
+type Rate struct {
+	value  Decimal
+	period RatePeriod
+	index  string
+}
+
+type rateJSON struct {
+	Value  Decimal    `json:"value"`
+	Period RatePeriod `json:"period"`
+	Index  string     `json:"index,omitempty"`
+}
+
+func rateError(input any, constraint, message string, limit any) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "rate", Input: input, Constraint: constraint, Limit: limit}.ContextMap(),
+		message,
+	)
+}
+
+func ratePeriodError(period RatePeriod) error {
+	message := fmt.Sprintf("Rate period '%s' is not valid.", period)
+	return rateError(string(period), "period", message, []RatePeriod{RatePeriodDaily, RatePeriodMonthly, RatePeriodYearly, RatePeriodBusinessDay})
+}
+
+func newRate(value Decimal, period RatePeriod, index string) (Rate, error) {
+	if !period.IsValid() {
+		return Rate{}, ratePeriodError(period)
+	}
+	if value.Cmp(NewDecimalFromInt(-1)) <= 0 {
+		message := fmt.Sprintf("Rate %s must be greater than -100%%.", value)
+		return Rate{}, rateError(value.String(), "range", message, "-1")
+	}
+	return Rate{value: value, period: period, index: index}, nil
+}
+
+func parseRateValue(value string) (Decimal, error) {
+	d, err := NewDecimal(value)
+	if err != nil {
+		message := fmt.Sprintf("Rate '%s' is not a valid decimal number.", value)
+		return Decimal{}, msg.NewValidationError(err,
+			msg.ValidationContext{Field: "rate", Input: value, Constraint: "format"}.ContextMap(),
+			message,
+		)
+	}
+	return d, nil
+}
+
+// NewRate cria a taxa a partir da fração decimal, ex.: ("0.0199", RatePeriodMonthly).
+func NewRate(fraction string, period RatePeriod) (Rate, error) {
+	d, err := parseRateValue(fraction)
+	if err != nil {
+		return Rate{}, err
+	}
+	return newRate(d, period, "")
+}
+
+// NewPercentRate cria a taxa a partir do percentual, ex.: ("1.99", RatePeriodMonthly).
+func NewPercentRate(percent string, period RatePeriod) (Rate, error) {
+	d, err := parseRateValue(percent)
+	if err != nil {
+		return Rate{}, err
+	}
+	if d.scale+2 > MaxDecimalScale {
+		return Rate{}, decimalScaleError(d.scale + 2)
+	}
+	return newRate(Decimal{unscaled: d.Unscaled(), scale: d.scale + 2}, period, "")
+}
+
+func MustNewRate(fraction string, period RatePeriod) Rate {
+	r, err := NewRate(fraction, period)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// NewIndexedRate cria "índice + spread", ex.: ("CDI", 2% a.a.).
+func NewIndexedRate(index string, spread Rate) (Rate, error) {
+	index = strings.ToUpper(strings.TrimSpace(index))
+	if index == "" {
+		return Rate{}, rateError(index, "index", "Indexed rate requires an index name.", nil)
+	}
+	if spread.index != "" {
+		message := fmt.Sprintf("Spread of indexed rate %s is already indexed to %s.", index, spread.index)
+		return Rate{}, rateError(spread.index, "index", message, nil)
+	}
+	return newRate(spread.value, spread.period, index)
+}
+
+func (r Rate) Fraction() Decimal {
+	return r.value
+}
+
+// Percent retorna a taxa multiplicada por 100 sem perda de precisão.
+func (r Rate) Percent() Decimal {
+	if r.value.scale >= 2 {
+		return Decimal{unscaled: r.value.Unscaled(), scale: r.value.scale - 2}
+	}
+	return Decimal{unscaled: r.value.rescaled(2), scale: 0}
+}
+
+func (r Rate) Period() RatePeriod { return r.period }
+func (r Rate) Index() string      { return r.index }
+func (r Rate) IsIndexed() bool    { return r.index != "" }
+func (r Rate) IsZero() bool       { return r.value.IsZero() && r.index == "" }
+
+func (r Rate) String() string {
+	s := fmt.Sprintf("%s %s", r.value, r.period)
+	if r.index != "" {
+		return r.index + " + " + s
+	}
+	return s
+}
+
+// Format exibe a taxa como "1,99% a.m." ou "CDI + 2% a.a." (pt-BR) e "1.99% p.m." (en-US).
+func (r Rate) Format(locale Locale) (string, error) {
+	suffixes, ok := rateSuffixes[locale]
+	if !ok {
+		message := fmt.Sprintf("Locale '%s' is not supported for rate formatting.", locale)
+		return "", msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "rate", Input: string(locale), Constraint: "locale", Limit: []Locale{LocalePtBR, LocaleEnUS}}.ContextMap(),
+			message,
+		).WithContext(msg.KeyLocale, string(locale))
+	}
+	percent := r.Percent().String()
+	if strings.Contains(percent, ".") {
+		percent = strings.TrimRight(strings.TrimRight(percent, "0"), ".")
+	}
+	if locale == LocalePtBR {
+		percent = strings.Replace(percent, ".", ",", 1)
+	}
+	s := fmt.Sprintf("%s%% %s", percent, suffixes[r.period])
+	if r.index != "" {
+		return r.index + " + " + s, nil
+	}
+	return s, nil
+}
+
+func (r Rate) requireResolved(operation string) error {
+	if r.index == "" {
+		return nil
+	}
+	message := fmt.Sprintf("Rate %s must be resolved against %s before %s.", r, r.index, operation)
+	return msg.NewDomainError(ErrIndexedRate, message,
+		msg.ValidationContext{Field: "rate", Input: r.index, Constraint: "indexed"}.ContextMap(),
+	).WithContext(msg.KeyOperation, operation)
+}
+
+func validateRatePeriods(periods ...RatePeriod) error {
+	for _, p := range periods {
+		if !p.IsValid() {
+			return ratePeriodError(p)
+		}
+	}
+	return nil
+}
+
+func periodRatio(from, to RatePeriod) *big.Rat {
+	return new(big.Rat).Quo(to.years(), from.years())
+}
+
+// ConvertSimple converte proporcionalmente (juros simples), ex.: 1% a.m. = 12% a.a.
+func (r Rate) ConvertSimple(period RatePeriod, scale int, mode RoundingMode) (Rate, error) {
+	if err := validateRatePeriods(r.period, period); err != nil {
+		return Rate{}, err
+	}
+	converted := new(big.Rat).Mul(r.value.Rat(), periodRatio(r.period, period))
+	d, err := roundRatToDecimal(converted, scale, mode)
+	if err != nil {
+		return Rate{}, err
+	}
+	return newRate(d, period, r.index)
+}
+
+// ConvertCompound converte pela taxa equivalente (juros compostos): (1+i)^(n) - 1.
+// Raízes são calculadas com dígitos de guarda e arredondadas para scale.
+func (r Rate) ConvertCompound(period RatePeriod, scale int, mode RoundingMode) (Rate, error) {
+	if err := validateRatePeriods(r.period, period); err != nil {
+		return Rate{}, err
+	}
+	if scale < 0 || scale > MaxDecimalScale {
+		return Rate{}, decimalScaleError(scale)
+	}
+	if !mode.IsValid() {
+		return Rate{}, roundingModeError(mode)
+	}
+	ratio := periodRatio(r.period, period)
+	base := new(big.Rat).Add(big.NewRat(1, 1), r.value.Rat())
+	powered := ratPow(base, ratio.Num().Int64())
+
+	factor := powered
+	if q := ratio.Denom().Int64(); q != 1 {
+		digits := scale + rateGuardDigits
+		num := new(big.Int).Mul(powered.Num(), pow10(digits*int(q)))
+		num.Quo(num, powered.Denom())
+		factor = new(big.Rat).SetFrac(intRoot(num, q), pow10(digits))
+	}
+	converted := factor.Sub(factor, big.NewRat(1, 1))
+	d, err := roundRatToDecimal(converted, scale, mode)
+	if err != nil {
+		return Rate{}, err
+	}
+	return newRate(d, period, r.index)
+}
+
+func ratPow(base *big.Rat, n int64) *big.Rat {
+	exp := big.NewInt(n)
+	num := new(big.Int).Exp(base.Num(), exp, nil)
+	den := new(big.Int).Exp(base.Denom(), exp, nil)
+	return new(big.Rat).SetFrac(num, den)
+}
+
+// intRoot retorna a raiz n-ésima inteira (piso) de a ≥ 0 pelo método de Newton.
+func intRoot(a *big.Int, n int64) *big.Int {
+	if a.Sign() == 0 {
+		return new(big.Int)
+	}
+	x := new(big.Int).Lsh(big.NewInt(1), uint(a.BitLen()/int(n)+1))
+	bigN := big.NewInt(n)
+	nMinus1 := big.NewInt(n - 1)
+	for {
+		y := new(big.Int).Quo(a, new(big.Int).Exp(x, nMinus1, nil))
+		y.Add(y, new(big.Int).Mul(nMinus1, x))
+		y.Quo(y, bigN)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// Resolve combina índice e spread: (1+índice)(1+spread) - 1 no período do spread.
+func (r Rate) Resolve(index Rate, scale int, mode RoundingMode) (Rate, error) {
+	if r.index == "" {
+		return r, nil
+	}
+	if err := index.requireResolved("resolve"); err != nil {
+		return Rate{}, err
+	}
+	converted, err := index.ConvertCompound(r.period, scale+rateGuardDigits, RoundHalfEven)
+	if err != nil {
+		return Rate{}, err
+	}
+	combined := new(big.Rat).Add(big.NewRat(1, 1), converted.value.Rat())
+	combined.Mul(combined, new(big.Rat).Add(big.NewRat(1, 1), r.value.Rat()))
+	combined.Sub(combined, big.NewRat(1, 1))
+	d, err := roundRatToDecimal(combined, scale, mode)
+	if err != nil {
+		return Rate{}, err
+	}
+	return newRate(d, r.period, "")
+}
+
+func (r Rate) interest(m Money, factor *big.Rat, mode RoundingMode, operation string) (Money, error) {
+	if err := r.requireResolved(operation); err != nil {
+		return Money{}, err
+	}
+	if err := validateMoneyCurrency(m.currency); err != nil {
+		return Money{}, err
+	}
+	if !mode.IsValid() {
+		return Money{}, roundingModeError(mode)
+	}
+	amount := roundRat(new(big.Rat).Mul(ratInt64(m.amount), factor), mode)
+	if !amount.IsInt64() {
+		return Money{}, moneyOverflowError(operation, m.amount, 0)
+	}
+	return Money{amount: amount.Int64(), currency: m.currency}, nil
+}
+
+func ratePeriodsError(periods int) error {
+	message := fmt.Sprintf("Number of rate periods cannot be negative, got %d.", periods)
+	return rateError(periods, "periods", message, 0)
+}
+
+// SimpleInterest retorna os juros simples sobre m após n períodos: m × i × n.
+func (r Rate) SimpleInterest(m Money, periods int, mode RoundingMode) (Money, error) {
+	if periods < 0 {
+		return Money{}, ratePeriodsError(periods)
+	}
+	factor := new(big.Rat).Mul(r.value.Rat(), ratInt64(int64(periods)))
+	return r.interest(m, factor, mode, "simple_interest")
+}
+
+// CompoundInterest retorna os juros compostos sobre m após n períodos: m × ((1+i)^n - 1).
+func (r Rate) CompoundInterest(m Money, periods int, mode RoundingMode) (Money, error) {
+	if periods < 0 {
+		return Money{}, ratePeriodsError(periods)
+	}
+	factor := ratPow(new(big.Rat).Add(big.NewRat(1, 1), r.value.Rat()), int64(periods))
+	factor.Sub(factor, big.NewRat(1, 1))
+	return r.interest(m, factor, mode, "compound_interest")
+}
+
+// ValidateBounds verifica min ≤ r ≤ max; limites em outros períodos são convertidos
+// pela taxa equivalente composta.
+func (r Rate) ValidateBounds(lower, upper Rate) error {
+	for _, bound := range []struct {
+		rate       Rate
+		constraint string
+		violated   func(cmp int) bool
+	}{
+		{lower, "min", func(cmp int) bool { return cmp < 0 }},
+		{upper, "max", func(cmp int) bool { return cmp > 0 }},
+	} {
+		limit := bound.rate
+		if limit.period != r.period {
+			converted, err := limit.ConvertCompound(r.period, rateBoundsScale, RoundHalfEven)
+			if err != nil {
+				return err
+			}
+			limit = converted
+		}
+		if bound.violated(r.value.Cmp(limit.value)) {
+			message := fmt.Sprintf("Rate %s is outside the allowed range (%s %s).", r, bound.constraint, bound.rate)
+			return rateError(r.value.String(), bound.constraint, message, bound.rate.String())
+		}
+	}
+	return nil
+}
+
+// MarshalJSON serializa o valor zero (sem período) como null, que UnmarshalJSON aceita.
+func (r Rate) MarshalJSON() ([]byte, error) {
+	if r.period == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(rateJSON{Value: r.value, Period: r.period, Index: r.index})
+}
+
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = Rate{}
+		return nil
+	}
+	var raw rateJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		message := fmt.Sprintf("Rate must be a JSON object with value and period (received: %s).", string(data))
+		return msg.NewValidationError(err,
+			msg.ConversionContext{TargetType: "Rate", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
+			message,
+		)
+	}
+	rate, err := newRate(raw.Value, raw.Period, strings.ToUpper(strings.TrimSpace(raw.Index)))
+	if err != nil {
+		return err
+	}
+	*r = rate
+	return nil
+}
+
+// Value grava a taxa não inicializada (sem período) como NULL, como em MarshalJSON.
+func (r Rate) Value() (driver.Value, error) {
+	if r.period == "" {
+		return nil, nil
+	}
+	data, err := r.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (r *Rate) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	case nil:
+		*r = Rate{}
+		return nil
+	default:
+		message := fmt.Sprintf("Incompatible type (%T) for Rate. Expected JSON string or []byte.", src)
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "Rate", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
+	}
+	if err := r.UnmarshalJSON(data); err != nil {
+		if msgErr, ok := err.(*msg.MessageError); ok {
+			msgErr.WithContext(msg.KeySource, msg.SourceDB)
+			return msgErr
+		}
+		return err
+	}
+	return nil
+}
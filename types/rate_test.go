@@ -0,0 +1,220 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestNewRate(t *testing.T) {
+	r, err := types.NewRate("0.0199", types.RatePeriodMonthly)
+	require.NoError(t, err)
+	assert.Equal(t, "0.0199", r.Fraction().String())
+	assert.Equal(t, "1.99", r.Percent().String())
+	assert.Equal(t, types.RatePeriodMonthly, r.Period())
+
+	p, err := types.NewPercentRate("1.99", types.RatePeriodMonthly)
+	require.NoError(t, err)
+	assert.True(t, r.Fraction().Equals(p.Fraction()))
+
+	_, err = types.NewPercentRate("0."+strings.Repeat("0", types.MaxDecimalScale-1)+"1", types.RatePeriodMonthly)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "scale", msgErr.Context[msg.KeyConstraint])
+
+	whole, err := types.NewRate("2", types.RatePeriodYearly)
+	require.NoError(t, err)
+	assert.Equal(t, "200", whole.Percent().String())
+}
+
+func TestNewRate_Invalid(t *testing.T) {
+	testCases := []struct {
+		name       string
+		value      string
+		period     types.RatePeriod
+		constraint string
+	}{
+		{"format", "1,99%", types.RatePeriodMonthly, "format"},
+		{"period", "0.01", types.RatePeriod("weekly"), "period"},
+		{"minus 100 percent", "-1", types.RatePeriodYearly, "range"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.NewRate(tc.value, tc.period)
+			msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+			assert.Equal(t, "rate", msgErr.Context[msg.KeyField])
+			assert.Equal(t, tc.constraint, msgErr.Context[msg.KeyConstraint])
+		})
+	}
+}
+
+func TestRate_Format(t *testing.T) {
+	monthly := types.MustNewRate("0.0199", types.RatePeriodMonthly)
+	s, err := monthly.Format(types.LocalePtBR)
+	require.NoError(t, err)
+	assert.Equal(t, "1,99% a.m.", s)
+
+	s, err = monthly.Format(types.LocaleEnUS)
+	require.NoError(t, err)
+	assert.Equal(t, "1.99% p.m.", s)
+
+	indexed, err := types.NewIndexedRate("cdi", types.MustNewRate("0.02", types.RatePeriodYearly))
+	require.NoError(t, err)
+	s, err = indexed.Format(types.LocalePtBR)
+	require.NoError(t, err)
+	assert.Equal(t, "CDI + 2% a.a.", s)
+
+	s, err = types.MustNewRate("0.00045", types.RatePeriodBusinessDay).Format(types.LocalePtBR)
+	require.NoError(t, err)
+	assert.Equal(t, "0,045% a.d.u.", s)
+
+	_, err = monthly.Format(types.Locale("fr-FR"))
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "locale", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestRate_ConvertSimple(t *testing.T) {
+	monthly := types.MustNewRate("0.01", types.RatePeriodMonthly)
+
+	yearly, err := monthly.ConvertSimple(types.RatePeriodYearly, 4, types.RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, "0.1200", yearly.Fraction().String())
+
+	daily, err := monthly.ConvertSimple(types.RatePeriodDaily, 6, types.RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, "0.000333", daily.Fraction().String())
+	assert.Equal(t, types.RatePeriodDaily, daily.Period())
+}
+
+func TestRate_ConvertCompound(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rate     types.Rate
+		period   types.RatePeriod
+		scale    int
+		expected string
+	}{
+		{"monthly to yearly", types.MustNewRate("0.01", types.RatePeriodMonthly), types.RatePeriodYearly, 6, "0.126825"},
+		{"yearly to monthly", types.MustNewRate("0.12", types.RatePeriodYearly), types.RatePeriodMonthly, 8, "0.00948879"},
+		{"yearly to business day", types.MustNewRate("0.1365", types.RatePeriodYearly), types.RatePeriodBusinessDay, 8, "0.00050788"},
+		{"monthly to business day", types.MustNewRate("0.01", types.RatePeriodMonthly), types.RatePeriodBusinessDay, 8, "0.00047394"},
+		{"same period", types.MustNewRate("0.015", types.RatePeriodMonthly), types.RatePeriodMonthly, 4, "0.0150"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, err := tc.rate.ConvertCompound(tc.period, tc.scale, types.RoundHalfEven)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, converted.Fraction().String())
+			assert.Equal(t, tc.period, converted.Period())
+		})
+	}
+
+	_, err := types.Rate{}.ConvertCompound(types.RatePeriodYearly, 4, types.RoundHalfEven)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "period", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestRate_Interest(t *testing.T) {
+	rate := types.MustNewRate("0.01", types.RatePeriodMonthly)
+	principal := types.MustNewMoney(100000, types.BRL)
+
+	simple, err := rate.SimpleInterest(principal, 12, types.RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12000), simple.Amount())
+
+	compound, err := rate.CompoundInterest(principal, 12, types.RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12683), compound.Amount())
+	assert.Equal(t, types.BRL, compound.Currency())
+
+	_, err = rate.SimpleInterest(principal, -1, types.RoundHalfEven)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "periods", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestRate_IndexedRequiresResolution(t *testing.T) {
+	indexed, err := types.NewIndexedRate("CDI", types.MustNewRate("0.02", types.RatePeriodYearly))
+	require.NoError(t, err)
+	assert.True(t, indexed.IsIndexed())
+
+	_, err = indexed.SimpleInterest(types.MustNewMoney(100000, types.BRL), 1, types.RoundHalfEven)
+	requireMsgErrorCode(t, err, msg.CodeDomainViolation)
+	assert.True(t, errors.Is(err, types.ErrIndexedRate))
+
+	resolved, err := indexed.Resolve(types.MustNewRate("0.10", types.RatePeriodYearly), 4, types.RoundHalfEven)
+	require.NoError(t, err)
+	assert.False(t, resolved.IsIndexed())
+	assert.Equal(t, "0.1220", resolved.Fraction().String())
+
+	_, err = types.NewIndexedRate(" ", types.MustNewRate("0.02", types.RatePeriodYearly))
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "index", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestRate_ValidateBounds(t *testing.T) {
+	lower := types.MustNewRate("0", types.RatePeriodMonthly)
+	upper := types.MustNewRate("0.12", types.RatePeriodYearly)
+
+	assert.NoError(t, types.MustNewRate("0.009", types.RatePeriodMonthly).ValidateBounds(lower, upper))
+
+	err := types.MustNewRate("0.02", types.RatePeriodMonthly).ValidateBounds(lower, upper)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "max", msgErr.Context[msg.KeyConstraint])
+
+	err = types.MustNewRate("-0.001", types.RatePeriodMonthly).ValidateBounds(lower, upper)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "min", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestRate_JSON(t *testing.T) {
+	indexed, err := types.NewIndexedRate("CDI", types.MustNewRate("0.02", types.RatePeriodYearly))
+	require.NoError(t, err)
+
+	data, err := json.Marshal(indexed)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"value":"0.02","period":"yearly","index":"CDI"}`, string(data))
+
+	var decoded types.Rate
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, indexed, decoded)
+
+	err = json.Unmarshal([]byte(`1.99`), &decoded)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "Rate", msgErr.Context[msg.KeyTargetType])
+
+	err = json.Unmarshal([]byte(`{"value":"0.01","period":"weekly"}`), &decoded)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "period", msgErr.Context[msg.KeyConstraint])
+
+	data, err = json.Marshal(types.Rate{})
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, types.Rate{}, decoded)
+}
+
+func TestRate_SQL(t *testing.T) {
+	rate := types.MustNewRate("0.0199", types.RatePeriodMonthly)
+	value, err := rate.Value()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"value":"0.0199","period":"monthly"}`, value.(string))
+
+	var scanned types.Rate
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, rate, scanned)
+
+	value, err = types.Rate{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value, "the zero Rate is stored as NULL")
+	require.NoError(t, scanned.Scan(nil))
+	assert.Equal(t, types.Rate{}, scanned)
+
+	msgErr := requireMsgErrorCode(t, scanned.Scan(int64(1)), msg.CodeInvalid)
+	assert.Equal(t, "int64", msgErr.Context[msg.KeyReceivedType])
+}
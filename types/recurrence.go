@@ -0,0 +1,302 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily   RecurrenceFrequency = "daily"
+	RecurrenceWeekly  RecurrenceFrequency = "weekly"
+	RecurrenceMonthly RecurrenceFrequency = "monthly"
+	RecurrenceYearly  RecurrenceFrequency = "yearly"
+)
+
+func (f RecurrenceFrequency) IsValid() bool {
+	switch f {
+	case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly, RecurrenceYearly:
+		return true
+	default:
+		return false
+	}
+}
+
+// maxSkippedPeriods interrompe regras que deixam de gerar datas, ex.: 29/02 a cada
+// 4 anos começando em ano não bissexto com MonthEndSkip.
+const maxSkippedPeriods = 48
+
+var defaultCalendar = NewBrazilianCalendar()
+
+// RecurrenceRule descreve datas recorrentes de cobrança, ex.: mensal no dia 10,
+// a cada 3 meses, anual em 15/03 ou no último dia útil do mês. Datas anteriores
+// a Start não são geradas.
+type RecurrenceRule struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	// Interval é o número de períodos entre ocorrências; zero equivale a 1.
+	Interval int `json:"interval,omitempty"`
+	// Day é o dia do mês nas regras mensais e anuais; zero usa o dia de Start.
+	Day Day `json:"day,omitempty"`
+	// Month é o mês das regras anuais; zero usa o mês de Start.
+	Month time.Month `json:"month,omitempty"`
+	// LastBusinessDay gera o último dia útil do mês nas regras mensais.
+	LastBusinessDay bool `json:"last_business_day,omitempty"`
+	// MonthEndPolicy trata dias inexistentes no mês; o padrão é MonthEndClamp.
+	MonthEndPolicy MonthEndPolicy `json:"month_end_policy,omitempty"`
+	Start          Date           `json:"start"`
+	// Count limita o número de ocorrências; zero é ilimitado.
+	Count int `json:"count,omitempty"`
+	// Until limita as ocorrências até esta data, inclusive.
+	Until Date `json:"until,omitzero"`
+
+	calendar *Calendar
+}
+
+func recurrenceError(input any, constraint, message string) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "recurrence", Input: input, Constraint: constraint}.ContextMap(),
+		message,
+	)
+}
+
+// WithCalendar define o calendário usado por LastBusinessDay; o padrão é NewBrazilianCalendar.
+func (r RecurrenceRule) WithCalendar(cal *Calendar) RecurrenceRule {
+	r.calendar = cal
+	return r
+}
+
+func (r RecurrenceRule) Validate() error {
+	if !r.Frequency.IsValid() {
+		return recurrenceError(string(r.Frequency), "frequency", fmt.Sprintf("Recurrence frequency '%s' is not valid.", r.Frequency))
+	}
+	if r.Interval < 0 {
+		return recurrenceError(r.Interval, "interval", fmt.Sprintf("Recurrence interval cannot be negative, got %d.", r.Interval))
+	}
+	if r.Count < 0 {
+		return recurrenceError(r.Count, "count", fmt.Sprintf("Recurrence count cannot be negative, got %d.", r.Count))
+	}
+	if r.Start.IsZero() {
+		return recurrenceError(nil, "start", "Recurrence start date is required.")
+	}
+	if !r.Until.IsZero() && r.Until.Before(r.Start) {
+		message := fmt.Sprintf("Recurrence end date %s is before the start date %s.", r.Until, r.Start)
+		return recurrenceError(r.Until.String(), "until", message)
+	}
+	if r.Day != 0 {
+		if err := r.Day.validate(); err != nil {
+			return err
+		}
+	}
+	if r.MonthEndPolicy != "" {
+		switch r.MonthEndPolicy {
+		case MonthEndClamp, MonthEndRollover, MonthEndSkip:
+		default:
+			return monthEndPolicyError(r.MonthEndPolicy)
+		}
+	}
+
+	calendarBased := r.Frequency == RecurrenceDaily || r.Frequency == RecurrenceWeekly
+	switch {
+	case calendarBased && (r.Day != 0 || r.Month != 0 || r.LastBusinessDay):
+		message := fmt.Sprintf("Recurrence frequency '%s' does not accept day, month or last business day.", r.Frequency)
+		return recurrenceError(string(r.Frequency), "combination", message)
+	case r.Frequency == RecurrenceMonthly && r.Month != 0:
+		return recurrenceError(string(r.Frequency), "combination", "Monthly recurrence does not accept a month.")
+	case r.LastBusinessDay && (r.Frequency != RecurrenceMonthly || r.Day != 0):
+		return recurrenceError(string(r.Frequency), "combination", "Last business day is only valid for monthly recurrence without a day.")
+	}
+	if r.Frequency == RecurrenceYearly {
+		if r.Month < 0 || r.Month > time.December {
+			return recurrenceError(int(r.Month), "month", fmt.Sprintf("Recurrence month must be between 1 and 12, got %d.", r.Month))
+		}
+		// 2024 é bissexto: 29/02 é aceito, 30/02 nunca ocorre.
+		if _, err := NewDate(2024, r.month(), r.day()); err != nil && r.MonthEndPolicy == MonthEndSkip {
+			message := fmt.Sprintf("Yearly recurrence on %02d/%02d never occurs with the skip policy.", r.day(), int(r.month()))
+			return recurrenceError(fmt.Sprintf("%02d/%02d", r.day(), int(r.month())), "combination", message)
+		}
+	}
+	return nil
+}
+
+func (r RecurrenceRule) interval() int {
+	return max(1, r.Interval)
+}
+
+func (r RecurrenceRule) day() int {
+	if r.Day != 0 {
+		return r.Day.Int()
+	}
+	return r.Start.day
+}
+
+func (r RecurrenceRule) month() time.Month {
+	if r.Month != 0 {
+		return r.Month
+	}
+	return r.Start.month
+}
+
+func (r RecurrenceRule) policy() MonthEndPolicy {
+	if r.MonthEndPolicy == "" {
+		return MonthEndClamp
+	}
+	return r.MonthEndPolicy
+}
+
+// occurrence retorna a data do k-ésimo período; ok é false quando o período é pulado.
+func (r RecurrenceRule) occurrence(k int) (Date, bool) {
+	step := k * r.interval()
+	switch r.Frequency {
+	case RecurrenceDaily:
+		return r.Start.AddDays(step), true
+	case RecurrenceWeekly:
+		return r.Start.AddDays(7 * step), true
+	case RecurrenceMonthly:
+		ym := r.Start.YearMonth().AddMonths(step)
+		if r.LastBusinessDay {
+			cal := r.calendar
+			if cal == nil {
+				cal = defaultCalendar
+			}
+			return cal.PreviousBusinessDay(ym.LastDay()), true
+		}
+		d, ok, _ := Day(r.day()).ResolveIn(ym, r.policy())
+		return d, ok
+	default:
+		ym := YearMonth{year: r.Start.year + step, month: r.month()}
+		d, ok, _ := Day(r.day()).ResolveIn(ym, r.policy())
+		return d, ok
+	}
+}
+
+// Occurrences valida a regra e retorna um iterador das suas datas em ordem, respeitando
+// Count e Until. Regras sem Count nem Until são infinitas; interrompa a iteração no consumidor.
+func (r RecurrenceRule) Occurrences() (iter.Seq[Date], error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return func(yield func(Date) bool) {
+		emitted, skipped := 0, 0
+		for k := 0; skipped < maxSkippedPeriods; k++ {
+			d, ok := r.occurrence(k)
+			if !ok || d.Before(r.Start) {
+				skipped++
+				continue
+			}
+			skipped = 0
+			if (!r.Until.IsZero() && d.After(r.Until)) || (r.Count > 0 && emitted >= r.Count) {
+				return
+			}
+			emitted++
+			if !yield(d) {
+				return
+			}
+		}
+	}, nil
+}
+
+// Next retorna as próximas n ocorrências estritamente posteriores a after.
+func (r RecurrenceRule) Next(after Date, n int) ([]Date, error) {
+	occurrences, err := r.Occurrences()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, recurrenceError(n, "count", fmt.Sprintf("Number of occurrences cannot be negative, got %d.", n))
+	}
+	dates := make([]Date, 0, n)
+	for d := range occurrences {
+		if len(dates) == n {
+			break
+		}
+		if d.After(after) {
+			dates = append(dates, d)
+		}
+	}
+	return dates, nil
+}
+
+// Between retorna as ocorrências entre from e to, inclusive.
+func (r RecurrenceRule) Between(from, to Date) ([]Date, error) {
+	occurrences, err := r.Occurrences()
+	if err != nil {
+		return nil, err
+	}
+	var dates []Date
+	for d := range occurrences {
+		if d.After(to) {
+			break
+		}
+		if !d.Before(from) {
+			dates = append(dates, d)
+		}
+	}
+	return dates, nil
+}
+
+type recurrenceRuleJSON RecurrenceRule
+
+func (r *RecurrenceRule) UnmarshalJSON(data []byte) error {
+	var raw recurrenceRuleJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		// Erros de campos do tipo Date já descrevem o valor inválido.
+		if msgErr, ok := err.(*msg.MessageError); ok {
+			return msgErr
+		}
+		message := fmt.Sprintf("RecurrenceRule must be a valid JSON object (received: %s).", string(data))
+		return msg.NewValidationError(err,
+			msg.ConversionContext{TargetType: "RecurrenceRule", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
+			message,
+		)
+	}
+	rule := RecurrenceRule(raw)
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	rule.calendar = r.calendar
+	*r = rule
+	return nil
+}
+
+func (r RecurrenceRule) Value() (driver.Value, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (r *RecurrenceRule) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	case nil:
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "RecurrenceRule", Source: msg.SourceDB}.ContextMap(),
+			"Scanned nil value for non-nullable RecurrenceRule.",
+		)
+	default:
+		message := fmt.Sprintf("Incompatible type (%T) for RecurrenceRule. Expected JSON string or []byte.", src)
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "RecurrenceRule", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
+	}
+	if err := r.UnmarshalJSON(data); err != nil {
+		if msgErr, ok := err.(*msg.MessageError); ok {
+			msgErr.WithContext(msg.KeySource, msg.SourceDB)
+			return msgErr
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package types_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestRecurrenceRule_Occurrences(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rule     types.RecurrenceRule
+		expected []types.Date
+	}{
+		{
+			name:     "monthly on day 10",
+			rule:     types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Day: 10, Start: date(2026, time.October, 16), Count: 3},
+			expected: []types.Date{date(2026, time.November, 10), date(2026, time.December, 10), date(2027, time.January, 10)},
+		},
+		{
+			name:     "every 3 months from month end",
+			rule:     types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Interval: 3, Start: date(2026, time.January, 31), Count: 4},
+			expected: []types.Date{date(2026, time.January, 31), date(2026, time.April, 30), date(2026, time.July, 31), date(2026, time.October, 31)},
+		},
+		{
+			name:     "yearly on 15/03",
+			rule:     types.RecurrenceRule{Frequency: types.RecurrenceYearly, Day: 15, Month: time.March, Start: date(2026, time.October, 16), Count: 2},
+			expected: []types.Date{date(2027, time.March, 15), date(2028, time.March, 15)},
+		},
+		{
+			name:     "last business day of the month",
+			rule:     types.RecurrenceRule{Frequency: types.RecurrenceMonthly, LastBusinessDay: true, Start: date(2026, time.October, 1), Count: 4},
+			expected: []types.Date{date(2026, time.October, 30), date(2026, time.November, 30), date(2026, time.December, 31), date(2027, time.January, 29)},
+		},
+		{
+			name:     "until date is inclusive",
+			rule:     types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Day: 10, Start: date(2026, time.October, 1), Until: date(2026, time.December, 10)},
+			expected: []types.Date{date(2026, time.October, 10), date(2026, time.November, 10), date(2026, time.December, 10)},
+		},
+		{
+			name:     "every other week",
+			rule:     types.RecurrenceRule{Frequency: types.RecurrenceWeekly, Interval: 2, Start: date(2026, time.October, 16), Count: 3},
+			expected: []types.Date{date(2026, time.October, 16), date(2026, time.October, 30), date(2026, time.November, 13)},
+		},
+		{
+			name:     "daily",
+			rule:     types.RecurrenceRule{Frequency: types.RecurrenceDaily, Start: date(2026, time.December, 30), Count: 3},
+			expected: []types.Date{date(2026, time.December, 30), date(2026, time.December, 31), date(2027, time.January, 1)},
+		},
+		{
+			name:     "day 31 skipping short months",
+			rule:     types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Day: 31, MonthEndPolicy: types.MonthEndSkip, Start: date(2026, time.January, 1), Count: 3},
+			expected: []types.Date{date(2026, time.January, 31), date(2026, time.March, 31), date(2026, time.May, 31)},
+		},
+		{
+			name:     "leap day skipping common years",
+			rule:     types.RecurrenceRule{Frequency: types.RecurrenceYearly, Day: 29, Month: time.February, MonthEndPolicy: types.MonthEndSkip, Start: date(2026, time.January, 1), Count: 2},
+			expected: []types.Date{date(2028, time.February, 29), date(2032, time.February, 29)},
+		},
+		{
+			name:     "rule that never occurs stops",
+			rule:     types.RecurrenceRule{Frequency: types.RecurrenceYearly, Interval: 4, Day: 29, Month: time.February, MonthEndPolicy: types.MonthEndSkip, Start: date(2025, time.January, 1)},
+			expected: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			occurrences, err := tc.rule.Occurrences()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, slices.Collect(occurrences))
+		})
+	}
+}
+
+func TestRecurrenceRule_WithCalendar(t *testing.T) {
+	cal := types.NewCalendar()
+	require.NoError(t, cal.AddHoliday(types.Holiday{Date: date(2026, time.November, 30), Name: "Feriado municipal", Scope: types.HolidayMunicipal}))
+	rule := types.RecurrenceRule{Frequency: types.RecurrenceMonthly, LastBusinessDay: true, Start: date(2026, time.November, 1), Count: 1}
+
+	occurrences, err := rule.WithCalendar(cal).Occurrences()
+	require.NoError(t, err)
+	assert.Equal(t, []types.Date{date(2026, time.November, 27)}, slices.Collect(occurrences))
+}
+
+func TestRecurrenceRule_NextAndBetween(t *testing.T) {
+	rule := types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Day: 10, Start: date(2026, time.October, 1)}
+
+	next, err := rule.Next(date(2026, time.November, 10), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Date{date(2026, time.December, 10), date(2027, time.January, 10)}, next)
+
+	between, err := rule.Between(date(2027, time.January, 1), date(2027, time.March, 31))
+	require.NoError(t, err)
+	assert.Equal(t, []types.Date{date(2027, time.January, 10), date(2027, time.February, 10), date(2027, time.March, 10)}, between)
+
+	limited := rule
+	limited.Count = 2
+	next, err = limited.Next(date(2026, time.October, 1), 5)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Date{date(2026, time.October, 10), date(2026, time.November, 10)}, next)
+
+	_, err = rule.Next(date(2026, time.October, 1), -1)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "count", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestRecurrenceRule_Validate(t *testing.T) {
+	start := date(2026, time.October, 16)
+	testCases := []struct {
+		name       string
+		rule       types.RecurrenceRule
+		field      string
+		constraint string
+	}{
+		{"unknown frequency", types.RecurrenceRule{Frequency: "biweekly", Start: start}, "recurrence", "frequency"},
+		{"negative interval", types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Interval: -1, Start: start}, "recurrence", "interval"},
+		{"negative count", types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Count: -1, Start: start}, "recurrence", "count"},
+		{"missing start", types.RecurrenceRule{Frequency: types.RecurrenceMonthly}, "recurrence", "start"},
+		{"until before start", types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Start: start, Until: date(2026, time.January, 1)}, "recurrence", "until"},
+		{"invalid day", types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Day: 32, Start: start}, "day", "range"},
+		{"invalid policy", types.RecurrenceRule{Frequency: types.RecurrenceMonthly, MonthEndPolicy: "last", Start: start}, "month_end_policy", "enum"},
+		{"weekly with day", types.RecurrenceRule{Frequency: types.RecurrenceWeekly, Day: 10, Start: start}, "recurrence", "combination"},
+		{"monthly with month", types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Month: time.March, Start: start}, "recurrence", "combination"},
+		{"last business day with day", types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Day: 10, LastBusinessDay: true, Start: start}, "recurrence", "combination"},
+		{"yearly last business day", types.RecurrenceRule{Frequency: types.RecurrenceYearly, LastBusinessDay: true, Start: start}, "recurrence", "combination"},
+		{"invalid month", types.RecurrenceRule{Frequency: types.RecurrenceYearly, Month: 13, Start: start}, "recurrence", "month"},
+		{"impossible yearly date", types.RecurrenceRule{Frequency: types.RecurrenceYearly, Day: 30, Month: time.February, MonthEndPolicy: types.MonthEndSkip, Start: start}, "recurrence", "combination"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.Validate()
+			msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+			assert.Equal(t, tc.field, msgErr.Context[msg.KeyField])
+			assert.Equal(t, tc.constraint, msgErr.Context[msg.KeyConstraint])
+
+			_, err = tc.rule.Next(start, 1)
+			assert.Error(t, err)
+			occurrences, err := tc.rule.Occurrences()
+			requireMsgErrorCode(t, err, msg.CodeInvalid)
+			assert.Nil(t, occurrences)
+		})
+	}
+}
+
+func TestRecurrenceRule_JSON(t *testing.T) {
+	rule := types.RecurrenceRule{
+		Frequency: types.RecurrenceYearly,
+		Day:       15,
+		Month:     time.March,
+		Start:     date(2026, time.October, 16),
+		Count:     5,
+	}
+
+	data, err := json.Marshal(rule)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"frequency":"yearly","day":15,"month":3,"start":"2026-10-16","count":5}`, string(data))
+
+	var decoded types.RecurrenceRule
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, rule, decoded)
+
+	data, err = json.Marshal(types.RecurrenceRule{Frequency: types.RecurrenceMonthly, LastBusinessDay: true, Start: date(2026, time.October, 1), Until: date(2027, time.October, 1)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"frequency":"monthly","last_business_day":true,"start":"2026-10-01","until":"2027-10-01"}`, string(data))
+
+	err = json.Unmarshal([]byte(`"monthly"`), &decoded)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "RecurrenceRule", msgErr.Context[msg.KeyTargetType])
+
+	err = json.Unmarshal([]byte(`{"frequency":"monthly","start":"16/13/2026"}`), &decoded)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "date", msgErr.Context[msg.KeyField])
+
+	err = json.Unmarshal([]byte(`{"frequency":"monthly"}`), &decoded)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "start", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestRecurrenceRule_ValueAndScan(t *testing.T) {
+	rule := types.RecurrenceRule{Frequency: types.RecurrenceMonthly, Interval: 3, Day: 10, Start: date(2026, time.October, 16)}
+
+	value, err := rule.Value()
+	require.NoError(t, err)
+
+	var scanned types.RecurrenceRule
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, rule, scanned)
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, rule, scanned)
+
+	err = scanned.Scan(nil)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+
+	err = scanned.Scan(42)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "int", msgErr.Context[msg.KeyReceivedType])
+
+	err = scanned.Scan(`{"frequency":"hourly","start":"2026-10-16"}`)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+}
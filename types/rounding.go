@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+var ErrInvalidRoundingMode = fmt.Errorf("invalid rounding mode")
+
+type RoundingMode string
+
+const (
+	RoundHalfEven RoundingMode = "half_even"
+	RoundHalfUp   RoundingMode = "half_up"
+	RoundHalfDown RoundingMode = "half_down"
+	RoundDown     RoundingMode = "down"
+	RoundUp       RoundingMode = "up"
+	RoundCeiling  RoundingMode = "ceiling"
+	RoundFloor    RoundingMode = "floor"
+)
+
+func NewRoundingMode(value string) (RoundingMode, error) {
+	mode := RoundingMode(strings.ToLower(strings.TrimSpace(value)))
+	if !mode.IsValid() {
+		return "", ErrInvalidRoundingMode
+	}
+	return mode, nil
+}
+
+func (m RoundingMode) String() string {
+	return string(m)
+}
+
+func (m RoundingMode) IsValid() bool {
+	switch m {
+	case RoundHalfEven, RoundHalfUp, RoundHalfDown, RoundDown, RoundUp, RoundCeiling, RoundFloor:
+		return true
+	default:
+		return false
+	}
+}
+
+func roundingModeError(mode RoundingMode) error {
+	message := fmt.Sprintf("Rounding mode '%s' is not valid.", mode)
+	return msg.NewValidationError(ErrInvalidRoundingMode,
+		msg.ValidationContext{Field: "rounding", Input: mode.String(), Constraint: "enum"}.ContextMap(),
+		message,
+	)
+}
+
+// roundRat arredonda um racional para inteiro segundo o modo informado.
+// RoundDown trunca em direção a zero e RoundUp se afasta de zero.
+func roundRat(r *big.Rat, mode RoundingMode) *big.Int {
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return q
+	}
+
+	sign := int64(r.Sign())
+	awayFromZero := func() *big.Int { return q.Add(q, big.NewInt(sign)) }
+
+	twiceRem := new(big.Int).Abs(rem)
+	twiceRem.Lsh(twiceRem, 1)
+	half := twiceRem.Cmp(r.Denom())
+
+	switch mode {
+	case RoundUp:
+		return awayFromZero()
+	case RoundCeiling:
+		if sign > 0 {
+			return awayFromZero()
+		}
+		return q
+	case RoundFloor:
+		if sign < 0 {
+			return awayFromZero()
+		}
+		return q
+	case RoundHalfUp:
+		if half >= 0 {
+			return awayFromZero()
+		}
+		return q
+	case RoundHalfDown:
+		if half > 0 {
+			return awayFromZero()
+		}
+		return q
+	case RoundHalfEven:
+		if half > 0 || (half == 0 && q.Bit(0) == 1) {
+			return awayFromZero()
+		}
+		return q
+	default:
+		return q
+	}
+}
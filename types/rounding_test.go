@@ -0,0 +1,41 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRoundingMode(t *testing.T) {
+	mode, err := NewRoundingMode(" HALF_EVEN ")
+	require.NoError(t, err)
+	assert.Equal(t, RoundHalfEven, mode)
+
+	_, err = NewRoundingMode("nearest")
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+}
+
+func TestRoundRat(t *testing.T) {
+	inputs := []string{"5/2", "-5/2", "7/2", "-7/2", "13/10", "-13/10", "17/10", "-17/10", "3"}
+	expected := map[RoundingMode][]int64{
+		RoundHalfEven: {2, -2, 4, -4, 1, -1, 2, -2, 3},
+		RoundHalfUp:   {3, -3, 4, -4, 1, -1, 2, -2, 3},
+		RoundHalfDown: {2, -2, 3, -3, 1, -1, 2, -2, 3},
+		RoundDown:     {2, -2, 3, -3, 1, -1, 1, -1, 3},
+		RoundUp:       {3, -3, 4, -4, 2, -2, 2, -2, 3},
+		RoundCeiling:  {3, -2, 4, -3, 2, -1, 2, -1, 3},
+		RoundFloor:    {2, -3, 3, -4, 1, -2, 1, -2, 3},
+	}
+
+	for mode, results := range expected {
+		t.Run(mode.String(), func(t *testing.T) {
+			for i, input := range inputs {
+				r, ok := new(big.Rat).SetString(input)
+				require.True(t, ok)
+				assert.Equal(t, results[i], roundRat(r, mode).Int64(), "%s rounding %s", mode, input)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// TimeOfDay é um horário civil (HH:MM[:SS]) sem data nem fuso. O valor zero é 00:00.
+type TimeOfDay struct {
+	seconds int
+}
+
+func NewTimeOfDay(hour, minute, second int) (TimeOfDay, error) {
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 || second < 0 || second > 59 {
+		input := fmt.Sprintf("%02d:%02d:%02d", hour, minute, second)
+		return TimeOfDay{}, msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "time_of_day", Input: input, Constraint: "range"}.ContextMap(),
+			fmt.Sprintf("Time of day %s does not exist.", input),
+		)
+	}
+	return TimeOfDay{seconds: hour*3600 + minute*60 + second}, nil
+}
+
+func MustNewTimeOfDay(hour, minute, second int) TimeOfDay {
+	t, err := NewTimeOfDay(hour, minute, second)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// TimeOfDayOf retorna o horário de t no fuso do próprio t.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay{seconds: t.Hour()*3600 + t.Minute()*60 + t.Second()}
+}
+
+// ParseTimeOfDay aceita "08:00" e "08:00:30"; frações de segundo, como as de colunas TIME, são descartadas.
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	s := strings.TrimSpace(value)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s = s[:i]
+	}
+	layout := "15:04"
+	if strings.Count(s, ":") == 2 {
+		layout = "15:04:05"
+	}
+	parsed, err := time.Parse(layout, s)
+	if err != nil {
+		message := fmt.Sprintf("Invalid time of day '%s'. Expected HH:MM or HH:MM:SS.", value)
+		return TimeOfDay{}, msg.NewValidationError(err,
+			msg.ValidationContext{Field: "time_of_day", Input: value, Constraint: "format"}.ContextMap(),
+			message,
+		)
+	}
+	return TimeOfDayOf(parsed), nil
+}
+
+func (t TimeOfDay) Hour() int   { return t.seconds / 3600 }
+func (t TimeOfDay) Minute() int { return t.seconds % 3600 / 60 }
+func (t TimeOfDay) Second() int { return t.seconds % 60 }
+
+// Seconds retorna os segundos desde a meia-noite.
+func (t TimeOfDay) Seconds() int { return t.seconds }
+
+// String retorna "08:00" ou, quando há segundos, "08:00:30".
+func (t TimeOfDay) String() string {
+	if t.Second() == 0 {
+		return fmt.Sprintf("%02d:%02d", t.Hour(), t.Minute())
+	}
+	return t.clock()
+}
+
+func (t TimeOfDay) clock() string {
+	return fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second())
+}
+
+// On retorna o instante do horário na data e no fuso informados.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	return time.Date(d.year, d.month, d.day, t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
+
+func (t TimeOfDay) Compare(other TimeOfDay) int {
+	switch {
+	case t.seconds < other.seconds:
+		return -1
+	case t.seconds > other.seconds:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (t TimeOfDay) Before(other TimeOfDay) bool { return t.seconds < other.seconds }
+func (t TimeOfDay) After(other TimeOfDay) bool  { return t.seconds > other.seconds }
+
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		message := fmt.Sprintf("TimeOfDay must be a JSON string in HH:MM format; received '%s'.", string(data))
+		return msg.NewValidationError(err,
+			msg.ConversionContext{TargetType: "TimeOfDay", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
+			message,
+		)
+	}
+	parsed, err := ParseTimeOfDay(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// Value grava o horário como "08:00:00", aceito por colunas TIME.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return t.clock(), nil
+}
+
+// Scan aceita texto de colunas TIME ("08:00:00" ou "08:00:00.000000") e time.Time.
+func (t *TimeOfDay) Scan(src interface{}) error {
+	var raw string
+	switch v := src.(type) {
+	case time.Time:
+		*t = TimeOfDayOf(v)
+		return nil
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	case nil:
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "TimeOfDay", Source: msg.SourceDB}.ContextMap(),
+			"Scanned nil value for non-nullable TimeOfDay.",
+		)
+	default:
+		message := fmt.Sprintf("Incompatible type (%T) for TimeOfDay. Expected string, []byte or time.Time.", src)
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "TimeOfDay", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
+	}
+	parsed, err := ParseTimeOfDay(raw)
+	if err != nil {
+		return scanErrorFromDB(err)
+	}
+	*t = parsed
+	return nil
+}
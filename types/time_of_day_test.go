@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected types.TimeOfDay
+		str      string
+	}{
+		{"08:00", types.MustNewTimeOfDay(8, 0, 0), "08:00"},
+		{" 18:30 ", types.MustNewTimeOfDay(18, 30, 0), "18:30"},
+		{"23:59:59", types.MustNewTimeOfDay(23, 59, 59), "23:59:59"},
+		{"00:00:00.000000", types.MustNewTimeOfDay(0, 0, 0), "00:00"},
+		{"07:15:30.5", types.MustNewTimeOfDay(7, 15, 30), "07:15:30"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			parsed, err := types.ParseTimeOfDay(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, parsed)
+			assert.Equal(t, tc.str, parsed.String())
+		})
+	}
+
+	for _, input := range []string{"", "24:00", "08:60", "8h00", "08:00:5x", "08:00:00:00"} {
+		_, err := types.ParseTimeOfDay(input)
+		msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+		assert.Equal(t, "time_of_day", msgErr.Context[msg.KeyField], input)
+		assert.Equal(t, "format", msgErr.Context[msg.KeyConstraint], input)
+	}
+}
+
+func TestNewTimeOfDay(t *testing.T) {
+	tod, err := types.NewTimeOfDay(13, 45, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 13, tod.Hour())
+	assert.Equal(t, 45, tod.Minute())
+	assert.Equal(t, 10, tod.Second())
+	assert.Equal(t, 13*3600+45*60+10, tod.Seconds())
+
+	_, err = types.NewTimeOfDay(24, 0, 0)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "range", msgErr.Context[msg.KeyConstraint])
+	assert.Equal(t, "24:00:00", msgErr.Context[msg.KeyInput])
+
+	assert.Panics(t, func() { types.MustNewTimeOfDay(0, -1, 0) })
+}
+
+func TestTimeOfDay_OnAndCompare(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	opening := types.MustNewTimeOfDay(8, 0, 0)
+	at := opening.On(date(2026, time.October, 16), saoPaulo)
+	assert.True(t, at.Equal(time.Date(2026, time.October, 16, 11, 0, 0, 0, time.UTC)))
+	assert.Equal(t, opening, types.TimeOfDayOf(at))
+
+	closing := types.MustNewTimeOfDay(18, 0, 0)
+	assert.True(t, opening.Before(closing))
+	assert.True(t, closing.After(opening))
+	assert.Equal(t, -1, opening.Compare(closing))
+	assert.Equal(t, 0, opening.Compare(types.MustNewTimeOfDay(8, 0, 0)))
+}
+
+func TestTimeOfDay_JSONAndSQL(t *testing.T) {
+	tod := types.MustNewTimeOfDay(8, 30, 0)
+
+	data, err := json.Marshal(tod)
+	require.NoError(t, err)
+	assert.Equal(t, `"08:30"`, string(data))
+
+	var decoded types.TimeOfDay
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, tod, decoded)
+
+	err = json.Unmarshal([]byte(`830`), &decoded)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "TimeOfDay", msgErr.Context[msg.KeyTargetType])
+
+	value, err := tod.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "08:30:00", value)
+
+	var scanned types.TimeOfDay
+	require.NoError(t, scanned.Scan([]byte("08:30:00")))
+	assert.Equal(t, tod, scanned)
+	require.NoError(t, scanned.Scan(time.Date(0, time.January, 1, 8, 30, 0, 0, time.UTC)))
+	assert.Equal(t, tod, scanned)
+
+	err = scanned.Scan(nil)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+	err = scanned.Scan("25:00:00")
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+	err = scanned.Scan(int64(830))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "int64", msgErr.Context[msg.KeyReceivedType])
+}
@@ -16,7 +16,7 @@ var Nil UUID
 func NewUUID() (UUID, error) {
 	id, err := uuid.NewV7()
 	if err != nil {
-		return Nil, msg.NewInternalError(err, map[string]any{"operation": "generate_v7_uuid"})
+		return Nil, msg.NewInternalError(err, msg.OperationContext{Operation: "generate_v7_uuid"}.ContextMap())
 	}
 	return UUID(id), nil
 }
@@ -34,7 +34,7 @@ func ParseUUID(s string) (UUID, error) {
 	if err != nil {
 		message := fmt.Sprintf("Invalid UUID string format: '%s'.", s)
 		return Nil, msg.NewValidationError(err,
-			map[string]any{"input_string": s},
+			msg.ValidationContext{Field: "uuid", Input: s, Constraint: "format"}.ContextMap(),
 			message,
 		)
 	}
@@ -66,7 +66,7 @@ func (u *UUID) UnmarshalText(text []byte) error {
 	if err := underlyingUUID.UnmarshalText(text); err != nil {
 		message := fmt.Sprintf("Invalid text representation for UUID: '%s'.", string(text))
 		return msg.NewValidationError(err,
-			map[string]any{"input_text": string(text)},
+			msg.ConversionContext{TargetType: "UUID", Source: msg.SourceText, Input: string(text)}.ContextMap(),
 			message,
 		)
 	}
@@ -86,7 +86,7 @@ func (u *UUID) Scan(src interface{}) error {
 	if err := underlyingUUID.Scan(src); err != nil {
 		message := fmt.Sprintf("Failed to scan database value of type %T into UUID.", src)
 		return msg.NewValidationError(err,
-			map[string]any{"source_type": fmt.Sprintf("%T", src)},
+			msg.ConversionContext{TargetType: "UUID", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
 			message,
 		)
 	}
@@ -37,14 +37,14 @@ func (v Version) MarshalJSON() ([]byte, error) {
 func (v *Version) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		return msg.NewValidationError(nil,
-			map[string]any{"input_json": "null", "target_type": "Version"},
+			msg.ConversionContext{TargetType: "Version", Source: msg.SourceJSON, Input: "null"}.ContextMap(),
 			"Version cannot be null (received JSON 'null').",
 		)
 	}
 	var i int
 	if err := json.Unmarshal(data, &i); err != nil {
 		return msg.NewValidationError(err,
-			map[string]any{"input_json": string(data), "target_type": "Version"},
+			msg.ConversionContext{TargetType: "Version", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
 			"Version must be a JSON number.",
 		)
 	}
@@ -59,7 +59,7 @@ func (v Version) Value() (driver.Value, error) {
 func (v *Version) Scan(src interface{}) error {
 	if src == nil {
 		return msg.NewValidationError(nil,
-			map[string]any{"target_type": "Version"},
+			msg.ConversionContext{TargetType: "Version", Source: msg.SourceDB}.ContextMap(),
 			"Scanned nil value for non-nullable Version.",
 		)
 	}
@@ -71,7 +71,7 @@ func (v *Version) Scan(src interface{}) error {
 		if s > int64(maxInt) || s < int64(minInt) {
 			message := fmt.Sprintf("Value %d from database is out of range for Version (int).", s)
 			return msg.NewValidationError(nil,
-				map[string]any{"source_value": s},
+				msg.ValidationContext{Field: "version", Input: s, Constraint: "int_range"}.ContextMap(),
 				message,
 			)
 		}
@@ -81,7 +81,7 @@ func (v *Version) Scan(src interface{}) error {
 		if err != nil {
 			message := fmt.Sprintf("Failed to convert []byte ('%s') to int for Version.", string(s))
 			return msg.NewValidationError(err,
-				map[string]any{"input_bytes": string(s)},
+				msg.ConversionContext{TargetType: "Version", ReceivedType: "[]uint8", Source: msg.SourceDB, Input: string(s)}.ContextMap(),
 				message,
 			)
 		}
@@ -89,7 +89,7 @@ func (v *Version) Scan(src interface{}) error {
 	default:
 		message := fmt.Sprintf("Incompatible type (%T) for Version. Expected int64 or []byte.", src)
 		return msg.NewValidationError(nil,
-			map[string]any{"received_type": fmt.Sprintf("%T", src)},
+			msg.ConversionContext{TargetType: "Version", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
 			message,
 		)
 	}
@@ -0,0 +1,380 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+// maxScheduleSearchDays limita a busca de NextOpening e NextClosing; cobre um ano
+// inteiro de exceções, como um fechamento para reforma.
+const maxScheduleSearchDays = 400
+
+// ScheduleInterval é um horário de funcionamento dentro de um dia. End menor que Start
+// atravessa a meia-noite (22:00–02:00); End igual a Start cobre 24 horas.
+type ScheduleInterval struct {
+	Start TimeOfDay `json:"start"`
+	End   TimeOfDay `json:"end"`
+}
+
+func (i ScheduleInterval) IsOvernight() bool {
+	return !i.End.After(i.Start)
+}
+
+func (i ScheduleInterval) Duration() time.Duration {
+	seconds := i.End.seconds - i.Start.seconds
+	if i.IsOvernight() {
+		seconds += secondsPerDay
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// on retorna o intervalo concreto que abre na data d.
+func (i ScheduleInterval) on(d Date, loc *time.Location) TimeRange {
+	end := d
+	if i.IsOvernight() {
+		end = d.AddDays(1)
+	}
+	return TimeRange{start: i.Start.On(d, loc), end: i.End.On(end, loc)}
+}
+
+func (i ScheduleInterval) String() string {
+	return fmt.Sprintf("%s-%s", i.Start, i.End)
+}
+
+// ScheduleException substitui os horários de uma data específica; sem intervalos, o dia fica fechado.
+type ScheduleException struct {
+	Date      Date               `json:"date"`
+	Intervals []ScheduleInterval `json:"intervals"`
+	Note      string             `json:"note,omitempty"`
+}
+
+// WeeklySchedule é uma grade semanal de funcionamento, ex.: 08:00–18:00 de segunda a sexta,
+// avaliada no fuso da própria grade. Intervalos pertencem ao dia em que abrem: uma exceção
+// no sábado não encerra o turno de sexta que atravessa a meia-noite. O valor zero é uma
+// grade vazia em UTC.
+type WeeklySchedule struct {
+	location   *time.Location
+	days       [7][]ScheduleInterval
+	exceptions map[Date]ScheduleException
+}
+
+// NewWeeklySchedule cria uma grade vazia (sempre fechada); loc nil usa UTC.
+func NewWeeklySchedule(loc *time.Location) *WeeklySchedule {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &WeeklySchedule{location: loc, exceptions: make(map[Date]ScheduleException)}
+}
+
+func scheduleError(input any, constraint, message string) error {
+	return msg.NewValidationError(nil,
+		msg.ValidationContext{Field: "weekly_schedule", Input: input, Constraint: constraint}.ContextMap(),
+		message,
+	)
+}
+
+func validateWeekday(day time.Weekday) error {
+	if day < time.Sunday || day > time.Saturday {
+		return scheduleError(int(day), "weekday", fmt.Sprintf("Weekday %d is not valid.", day))
+	}
+	return nil
+}
+
+// validateIntervals rejeita intervalos que se sobrepõem no mesmo dia.
+func validateIntervals(intervals []ScheduleInterval) error {
+	for a := range intervals {
+		for b := a + 1; b < len(intervals); b++ {
+			first, second := intervals[a], intervals[b]
+			if second.Start.Before(first.Start) {
+				first, second = second, first
+			}
+			if time.Duration(second.Start.seconds-first.Start.seconds)*time.Second < first.Duration() {
+				message := fmt.Sprintf("Schedule intervals %s and %s overlap.", first, second)
+				return scheduleError(first.String(), "overlap", message)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSpillover rejeita intervalos de next que abrem antes do fim dos intervalos
+// de previous que atravessam a meia-noite, ex.: sexta 22:00–06:00 e sábado 05:00–10:00.
+func validateSpillover(previous, next []ScheduleInterval) error {
+	for _, overnight := range previous {
+		if !overnight.IsOvernight() {
+			continue
+		}
+		for _, interval := range next {
+			if interval.Start.seconds < overnight.End.seconds {
+				message := fmt.Sprintf("Schedule interval %s overlaps %s of the next day.", overnight, interval)
+				return scheduleError(overnight.String(), "overlap", message)
+			}
+		}
+	}
+	return nil
+}
+
+// validateDay verifica os intervalos do dia entre si e contra os dias vizinhos.
+func validateDay(days *[7][]ScheduleInterval, day time.Weekday) error {
+	if err := validateIntervals(days[day]); err != nil {
+		return err
+	}
+	if err := validateSpillover(days[(day+6)%7], days[day]); err != nil {
+		return err
+	}
+	return validateSpillover(days[day], days[(day+1)%7])
+}
+
+func sortIntervals(intervals []ScheduleInterval) {
+	slices.SortFunc(intervals, func(a, b ScheduleInterval) int { return a.Start.Compare(b.Start) })
+}
+
+// Location retorna o fuso da grade; UTC quando não definido.
+func (s *WeeklySchedule) Location() *time.Location {
+	if s.location == nil {
+		return time.UTC
+	}
+	return s.location
+}
+
+// Add inclui um intervalo no dia da semana. O trecho após a meia-noite de um intervalo
+// noturno não pode colidir com os intervalos do dia seguinte.
+func (s *WeeklySchedule) Add(day time.Weekday, start, end TimeOfDay) error {
+	return s.AddDays(day, day, start, end)
+}
+
+// AddDays inclui o intervalo de from até to, inclusive, ex.: time.Monday a time.Friday.
+// from posterior a to dá a volta na semana (sexta a segunda).
+func (s *WeeklySchedule) AddDays(from, to time.Weekday, start, end TimeOfDay) error {
+	if err := validateWeekday(from); err != nil {
+		return err
+	}
+	if err := validateWeekday(to); err != nil {
+		return err
+	}
+	updated := s.days
+	for day := from; ; day = (day + 1) % 7 {
+		updated[day] = append(slices.Clone(updated[day]), ScheduleInterval{Start: start, End: end})
+		if err := validateDay(&updated, day); err != nil {
+			return err
+		}
+		sortIntervals(updated[day])
+		if day == to {
+			break
+		}
+	}
+	s.days = updated
+	return nil
+}
+
+// AddException substitui os horários da data; sem intervalos, a data fica fechada.
+func (s *WeeklySchedule) AddException(exception ScheduleException) error {
+	if exception.Date.IsZero() {
+		return scheduleError(nil, "exception_date", "Schedule exception date is required.")
+	}
+	intervals := slices.Clone(exception.Intervals)
+	if err := validateIntervals(intervals); err != nil {
+		return err
+	}
+	sortIntervals(intervals)
+	exception.Intervals = intervals
+	if s.exceptions == nil {
+		s.exceptions = make(map[Date]ScheduleException)
+	}
+	s.exceptions[exception.Date] = exception
+	return nil
+}
+
+// Intervals retorna os intervalos regulares do dia da semana.
+func (s *WeeklySchedule) Intervals(day time.Weekday) []ScheduleInterval {
+	if validateWeekday(day) != nil {
+		return nil
+	}
+	return slices.Clone(s.days[day])
+}
+
+// IntervalsOn retorna os intervalos da data, considerando exceções.
+func (s *WeeklySchedule) IntervalsOn(d Date) []ScheduleInterval {
+	if exception, ok := s.exceptions[d]; ok {
+		return slices.Clone(exception.Intervals)
+	}
+	return slices.Clone(s.days[d.Weekday()])
+}
+
+// OpenRangesOn retorna os intervalos concretos que abrem na data, no fuso da grade.
+func (s *WeeklySchedule) OpenRangesOn(d Date) []TimeRange {
+	intervals := s.IntervalsOn(d)
+	ranges := make([]TimeRange, 0, len(intervals))
+	for _, interval := range intervals {
+		ranges = append(ranges, interval.on(d, s.Location()))
+	}
+	return ranges
+}
+
+// IsOpenAt informa se t cai em algum intervalo, inclusive turnos iniciados no dia anterior.
+func (s *WeeklySchedule) IsOpenAt(t time.Time) bool {
+	today := DateOf(t.In(s.Location()))
+	for _, d := range []Date{today.AddDays(-1), today} {
+		for _, r := range s.OpenRangesOn(d) {
+			if r.Contains(t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// periods percorre, em ordem, os períodos contínuos de funcionamento a partir do dia
+// anterior a from, unindo intervalos adjacentes (22:00–00:00 seguido de 00:00–06:00).
+// Um período ainda aberto no fim da busca não é retornado.
+func (s *WeeklySchedule) periods(from Date) iter.Seq[TimeRange] {
+	return func(yield func(TimeRange) bool) {
+		var current TimeRange
+		for offset := -1; offset <= maxScheduleSearchDays; offset++ {
+			for _, r := range s.OpenRangesOn(from.AddDays(offset)) {
+				switch {
+				case current.IsZero():
+					current = r
+				case !r.start.After(current.end):
+					if r.end.After(current.end) {
+						current.end = r.end
+					}
+				default:
+					if !yield(current) {
+						return
+					}
+					current = r
+				}
+			}
+		}
+	}
+}
+
+// NextOpening retorna o próximo instante, a partir de t inclusive, em que a grade passa de
+// fechada para aberta. ok é false se não houver abertura na janela de busca, como em grades
+// sempre fechadas ou abertas 24 horas.
+func (s *WeeklySchedule) NextOpening(t time.Time) (time.Time, bool) {
+	for p := range s.periods(DateOf(t.In(s.Location()))) {
+		if !p.start.Before(t) {
+			return p.start, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// NextClosing retorna o próximo instante, após t, em que a grade passa de aberta para fechada.
+func (s *WeeklySchedule) NextClosing(t time.Time) (time.Time, bool) {
+	for p := range s.periods(DateOf(t.In(s.Location()))) {
+		if p.end.After(t) {
+			return p.end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+var weekdayNames = [7]string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+type weeklyScheduleJSON struct {
+	Timezone   string                        `json:"timezone"`
+	Days       map[string][]ScheduleInterval `json:"days"`
+	Exceptions []ScheduleException           `json:"exceptions,omitempty"`
+}
+
+func (s WeeklySchedule) MarshalJSON() ([]byte, error) {
+	raw := weeklyScheduleJSON{Timezone: s.Location().String(), Days: make(map[string][]ScheduleInterval)}
+	for day, intervals := range s.days {
+		if len(intervals) > 0 {
+			raw.Days[weekdayNames[day]] = intervals
+		}
+	}
+	for _, exception := range s.exceptions {
+		if exception.Intervals == nil {
+			exception.Intervals = []ScheduleInterval{}
+		}
+		raw.Exceptions = append(raw.Exceptions, exception)
+	}
+	slices.SortFunc(raw.Exceptions, func(a, b ScheduleException) int { return a.Date.Compare(b.Date) })
+	return json.Marshal(raw)
+}
+
+func (s *WeeklySchedule) UnmarshalJSON(data []byte) error {
+	var raw weeklyScheduleJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		// Erros de campos como Date e TimeOfDay já descrevem o valor inválido.
+		if msgErr, ok := err.(*msg.MessageError); ok {
+			return msgErr
+		}
+		message := fmt.Sprintf("WeeklySchedule must be a JSON object with timezone and days (received: %s).", string(data))
+		return msg.NewValidationError(err,
+			msg.ConversionContext{TargetType: "WeeklySchedule", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
+			message,
+		)
+	}
+	loc, err := time.LoadLocation(raw.Timezone)
+	if err != nil {
+		message := fmt.Sprintf("Time zone '%s' is not valid.", raw.Timezone)
+		return msg.NewValidationError(err,
+			msg.ValidationContext{Field: "weekly_schedule", Input: raw.Timezone, Constraint: "timezone"}.ContextMap(),
+			message,
+		)
+	}
+	schedule := NewWeeklySchedule(loc)
+	for name, intervals := range raw.Days {
+		day := slices.Index(weekdayNames[:], strings.ToLower(name))
+		if day < 0 {
+			return scheduleError(name, "weekday", fmt.Sprintf("Weekday '%s' is not valid.", name))
+		}
+		for _, interval := range intervals {
+			if err := schedule.Add(time.Weekday(day), interval.Start, interval.End); err != nil {
+				return err
+			}
+		}
+	}
+	for _, exception := range raw.Exceptions {
+		if err := schedule.AddException(exception); err != nil {
+			return err
+		}
+	}
+	*s = *schedule
+	return nil
+}
+
+func (s WeeklySchedule) Value() (driver.Value, error) {
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (s *WeeklySchedule) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	case nil:
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "WeeklySchedule", Source: msg.SourceDB}.ContextMap(),
+			"Scanned nil value for non-nullable WeeklySchedule.",
+		)
+	default:
+		message := fmt.Sprintf("Incompatible type (%T) for WeeklySchedule. Expected JSON string or []byte.", src)
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "WeeklySchedule", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
+	}
+	if err := s.UnmarshalJSON(data); err != nil {
+		return scanErrorFromDB(err)
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func hm(hour, minute int) types.TimeOfDay {
+	return types.MustNewTimeOfDay(hour, minute, 0)
+}
+
+// storeSchedule: segunda a sexta 08:00–18:00, sexta 22:00–02:00, sábado 09:00–13:00,
+// fechado em 19/10/2026 e 10:00–14:00 em 20/10/2026.
+func storeSchedule(t *testing.T) (*types.WeeklySchedule, *time.Location) {
+	t.Helper()
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	s := types.NewWeeklySchedule(saoPaulo)
+	require.NoError(t, s.AddDays(time.Monday, time.Friday, hm(8, 0), hm(18, 0)))
+	require.NoError(t, s.Add(time.Friday, hm(22, 0), hm(2, 0)))
+	require.NoError(t, s.Add(time.Saturday, hm(9, 0), hm(13, 0)))
+	require.NoError(t, s.AddException(types.ScheduleException{Date: date(2026, time.October, 19), Note: "Inventário"}))
+	require.NoError(t, s.AddException(types.ScheduleException{
+		Date:      date(2026, time.October, 20),
+		Intervals: []types.ScheduleInterval{{Start: hm(10, 0), End: hm(14, 0)}},
+	}))
+	return s, saoPaulo
+}
+
+func TestWeeklySchedule_IsOpenAt(t *testing.T) {
+	s, loc := storeSchedule(t)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.October, day, hour, minute, 0, 0, loc)
+	}
+
+	testCases := []struct {
+		name     string
+		at       time.Time
+		expected bool
+	}{
+		{"friday morning", at(16, 10, 0), true},
+		{"opening instant", at(16, 8, 0), true},
+		{"closing instant", at(16, 18, 0), false},
+		{"friday evening", at(16, 19, 0), false},
+		{"friday overnight", at(16, 23, 30), true},
+		{"overnight spills into saturday", at(17, 1, 0), true},
+		{"after overnight", at(17, 3, 0), false},
+		{"saturday morning", at(17, 10, 0), true},
+		{"sunday", at(18, 10, 0), false},
+		{"closed exception", at(19, 10, 0), false},
+		{"reduced hours before opening", at(20, 9, 0), false},
+		{"reduced hours", at(20, 11, 0), true},
+		{"instant in another zone", time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC), true},
+		{"utc evening is local closing time", time.Date(2026, time.October, 16, 21, 30, 0, 0, time.UTC), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, s.IsOpenAt(tc.at))
+		})
+	}
+}
+
+func TestWeeklySchedule_NextOpeningAndClosing(t *testing.T) {
+	s, loc := storeSchedule(t)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.October, day, hour, minute, 0, 0, loc)
+	}
+
+	opening, ok := s.NextOpening(at(16, 19, 0))
+	require.True(t, ok)
+	assert.Equal(t, at(16, 22, 0), opening)
+
+	opening, ok = s.NextOpening(at(16, 8, 0))
+	require.True(t, ok)
+	assert.Equal(t, at(16, 8, 0), opening, "opening instant is the next opening")
+
+	opening, ok = s.NextOpening(at(16, 10, 0))
+	require.True(t, ok)
+	assert.Equal(t, at(16, 22, 0), opening, "while open, the next opening is after closing")
+
+	opening, ok = s.NextOpening(at(17, 14, 0))
+	require.True(t, ok)
+	assert.Equal(t, at(20, 10, 0), opening, "skips sunday and the closed exception")
+
+	closing, ok := s.NextClosing(at(16, 10, 0))
+	require.True(t, ok)
+	assert.Equal(t, at(16, 18, 0), closing)
+
+	closing, ok = s.NextClosing(at(16, 23, 0))
+	require.True(t, ok)
+	assert.Equal(t, at(17, 2, 0), closing)
+
+	closing, ok = s.NextClosing(at(17, 3, 0))
+	require.True(t, ok)
+	assert.Equal(t, at(17, 13, 0), closing)
+}
+
+func TestWeeklySchedule_AdjacentIntervalsMerge(t *testing.T) {
+	s := types.NewWeeklySchedule(nil)
+	require.NoError(t, s.Add(time.Friday, hm(22, 0), hm(0, 0)))
+	require.NoError(t, s.Add(time.Saturday, hm(0, 0), hm(6, 0)))
+
+	assert.True(t, s.IsOpenAt(time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)))
+	closing, ok := s.NextClosing(time.Date(2026, time.October, 16, 23, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, time.October, 17, 6, 0, 0, 0, time.UTC), closing)
+	assert.Equal(t, time.UTC, s.Location())
+}
+
+func TestWeeklySchedule_AlwaysOpenAndAlwaysClosed(t *testing.T) {
+	now := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	open := types.NewWeeklySchedule(time.UTC)
+	require.NoError(t, open.AddDays(time.Sunday, time.Saturday, hm(0, 0), hm(0, 0)))
+	assert.True(t, open.IsOpenAt(now))
+	_, ok := open.NextClosing(now)
+	assert.False(t, ok)
+	_, ok = open.NextOpening(now)
+	assert.False(t, ok)
+
+	closed := types.NewWeeklySchedule(time.UTC)
+	assert.False(t, closed.IsOpenAt(now))
+	_, ok = closed.NextOpening(now)
+	assert.False(t, ok)
+}
+
+func TestWeeklySchedule_ZeroValue(t *testing.T) {
+	now := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	var ws types.WeeklySchedule
+	assert.Equal(t, time.UTC, ws.Location())
+	assert.False(t, ws.IsOpenAt(now))
+	_, ok := ws.NextOpening(now)
+	assert.False(t, ok)
+	_, ok = ws.NextClosing(now)
+	assert.False(t, ok)
+
+	require.NoError(t, ws.Add(time.Friday, hm(8, 0), hm(18, 0)))
+	require.NoError(t, ws.AddException(types.ScheduleException{Date: date(2026, time.October, 23)}))
+	assert.True(t, ws.IsOpenAt(now))
+	assert.False(t, ws.IsOpenAt(now.AddDate(0, 0, 7)))
+
+	data, err := json.Marshal(ws)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"timezone":"UTC"`)
+}
+
+func TestWeeklySchedule_Intervals(t *testing.T) {
+	s := types.NewWeeklySchedule(time.UTC)
+	require.NoError(t, s.AddDays(time.Friday, time.Monday, hm(10, 0), hm(16, 0)))
+
+	assert.Len(t, s.Intervals(time.Sunday), 1)
+	assert.Len(t, s.Intervals(time.Monday), 1)
+	assert.Empty(t, s.Intervals(time.Wednesday))
+	assert.Nil(t, s.Intervals(time.Weekday(9)))
+
+	interval := types.ScheduleInterval{Start: hm(22, 0), End: hm(2, 0)}
+	assert.True(t, interval.IsOvernight())
+	assert.Equal(t, 4*time.Hour, interval.Duration())
+	assert.Equal(t, "22:00-02:00", interval.String())
+	assert.Equal(t, 24*time.Hour, types.ScheduleInterval{Start: hm(0, 0), End: hm(0, 0)}.Duration())
+
+	ranges := s.OpenRangesOn(date(2026, time.October, 16))
+	require.Len(t, ranges, 1)
+	assert.Equal(t, 6*time.Hour, ranges[0].Duration())
+}
+
+func TestWeeklySchedule_Errors(t *testing.T) {
+	s := types.NewWeeklySchedule(time.UTC)
+	require.NoError(t, s.Add(time.Monday, hm(8, 0), hm(12, 0)))
+
+	err := s.Add(time.Monday, hm(11, 0), hm(14, 0))
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "weekly_schedule", msgErr.Context[msg.KeyField])
+	assert.Equal(t, "overlap", msgErr.Context[msg.KeyConstraint])
+
+	err = s.AddDays(time.Sunday, time.Tuesday, hm(10, 0), hm(11, 0))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "overlap", msgErr.Context[msg.KeyConstraint])
+	assert.Empty(t, s.Intervals(time.Sunday), "failed AddDays leaves the schedule unchanged")
+
+	err = s.Add(time.Weekday(7), hm(8, 0), hm(12, 0))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "weekday", msgErr.Context[msg.KeyConstraint])
+
+	err = s.AddException(types.ScheduleException{})
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "exception_date", msgErr.Context[msg.KeyConstraint])
+
+	err = s.AddException(types.ScheduleException{
+		Date:      date(2026, time.December, 24),
+		Intervals: []types.ScheduleInterval{{Start: hm(22, 0), End: hm(2, 0)}, {Start: hm(23, 0), End: hm(23, 30)}},
+	})
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "overlap", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestWeeklySchedule_OvernightSpillover(t *testing.T) {
+	s := types.NewWeeklySchedule(nil)
+	require.NoError(t, s.Add(time.Friday, hm(22, 0), hm(6, 0)))
+	require.NoError(t, s.Add(time.Saturday, hm(6, 0), hm(10, 0)), "opening when the overnight shift closes is allowed")
+
+	err := s.Add(time.Saturday, hm(5, 0), hm(5, 30))
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "overlap", msgErr.Context[msg.KeyConstraint])
+
+	s = types.NewWeeklySchedule(nil)
+	require.NoError(t, s.Add(time.Saturday, hm(5, 0), hm(10, 0)))
+	err = s.Add(time.Friday, hm(22, 0), hm(6, 0))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "overlap", msgErr.Context[msg.KeyConstraint])
+	assert.Empty(t, s.Intervals(time.Friday))
+
+	err = s.AddDays(time.Thursday, time.Friday, hm(20, 0), hm(20, 0))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "overlap", msgErr.Context[msg.KeyConstraint], "a 24h shift spills into the next day")
+
+	s = types.NewWeeklySchedule(nil)
+	require.NoError(t, s.Add(time.Sunday, hm(8, 0), hm(12, 0)))
+	err = s.Add(time.Saturday, hm(23, 0), hm(9, 0))
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "overlap", msgErr.Context[msg.KeyConstraint], "saturday spills into sunday")
+
+	var decoded types.WeeklySchedule
+	err = json.Unmarshal([]byte(`{"timezone":"UTC","days":{"friday":[{"start":"22:00","end":"06:00"}],"saturday":[{"start":"05:00","end":"10:00"}]}}`), &decoded)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "overlap", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestWeeklySchedule_JSONAndSQL(t *testing.T) {
+	s, loc := storeSchedule(t)
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"timezone": "America/Sao_Paulo",
+		"days": {
+			"monday": [{"start":"08:00","end":"18:00"}],
+			"tuesday": [{"start":"08:00","end":"18:00"}],
+			"wednesday": [{"start":"08:00","end":"18:00"}],
+			"thursday": [{"start":"08:00","end":"18:00"}],
+			"friday": [{"start":"08:00","end":"18:00"},{"start":"22:00","end":"02:00"}],
+			"saturday": [{"start":"09:00","end":"13:00"}]
+		},
+		"exceptions": [
+			{"date":"2026-10-19","intervals":[],"note":"Inventário"},
+			{"date":"2026-10-20","intervals":[{"start":"10:00","end":"14:00"}]}
+		]
+	}`, string(data))
+
+	var decoded types.WeeklySchedule
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, loc.String(), decoded.Location().String())
+	assert.Equal(t, s.Intervals(time.Friday), decoded.Intervals(time.Friday))
+	assert.Empty(t, decoded.IntervalsOn(date(2026, time.October, 19)))
+	assert.True(t, decoded.IsOpenAt(time.Date(2026, time.October, 17, 1, 0, 0, 0, loc)))
+
+	value, err := s.Value()
+	require.NoError(t, err)
+	var scanned types.WeeklySchedule
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, s.Intervals(time.Saturday), scanned.Intervals(time.Saturday))
+
+	err = json.Unmarshal([]byte(`[]`), &decoded)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "WeeklySchedule", msgErr.Context[msg.KeyTargetType])
+
+	err = json.Unmarshal([]byte(`{"timezone":"Mars/Olympus","days":{}}`), &decoded)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "timezone", msgErr.Context[msg.KeyConstraint])
+
+	err = json.Unmarshal([]byte(`{"timezone":"UTC","days":{"segunda":[{"start":"08:00","end":"18:00"}]}}`), &decoded)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "weekday", msgErr.Context[msg.KeyConstraint])
+
+	err = json.Unmarshal([]byte(`{"timezone":"UTC","days":{"monday":[{"start":"8h","end":"18:00"}]}}`), &decoded)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "time_of_day", msgErr.Context[msg.KeyField])
+
+	err = scanned.Scan(`{"timezone":"UTC","days":{"monday":[{"start":"08:00","end":"18:00"},{"start":"09:00","end":"10:00"}]}}`)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+
+	err = scanned.Scan(nil)
+	requireMsgErrorCode(t, err, msg.CodeInvalid)
+	err = scanned.Scan(42)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "int", msgErr.Context[msg.KeyReceivedType])
+}
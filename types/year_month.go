@@ -0,0 +1,246 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/gobrick/msg"
+)
+
+const (
+	YearMonthLayoutISO = "2006-01"
+	YearMonthLayoutBR  = "01/2006"
+)
+
+// YearMonth é um mês civil (competência), ex.: 10/2026. O valor zero representa
+// competência ausente e é serializado como null/NULL.
+type YearMonth struct {
+	year  int
+	month time.Month
+}
+
+func NewYearMonth(year int, month time.Month) (YearMonth, error) {
+	if month < time.January || month > time.December || year < 1 || year > 9999 {
+		input := fmt.Sprintf("%04d-%02d", year, int(month))
+		return YearMonth{}, msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "year_month", Input: input, Constraint: "range"}.ContextMap(),
+			fmt.Sprintf("Year-month %s does not exist.", input),
+		)
+	}
+	return YearMonth{year: year, month: month}, nil
+}
+
+func MustNewYearMonth(year int, month time.Month) YearMonth {
+	ym, err := NewYearMonth(year, month)
+	if err != nil {
+		panic(err)
+	}
+	return ym
+}
+
+// YearMonthOf retorna a competência de t no fuso do próprio t.
+func YearMonthOf(t time.Time) YearMonth {
+	return YearMonth{year: t.Year(), month: t.Month()}
+}
+
+// ParseYearMonth aceita "2026-10" e "10/2026".
+func ParseYearMonth(value string) (YearMonth, error) {
+	s := strings.TrimSpace(value)
+	layout := YearMonthLayoutISO
+	if strings.Contains(s, "/") {
+		layout = YearMonthLayoutBR
+	}
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		message := fmt.Sprintf("Invalid year-month '%s'. Expected YYYY-MM or MM/YYYY.", value)
+		return YearMonth{}, msg.NewValidationError(err,
+			msg.ValidationContext{Field: "year_month", Input: value, Constraint: "format"}.ContextMap(),
+			message,
+		)
+	}
+	return NewYearMonth(t.Year(), t.Month())
+}
+
+func (d Date) YearMonth() YearMonth {
+	return YearMonth{year: d.year, month: d.month}
+}
+
+func (ym YearMonth) Year() int         { return ym.year }
+func (ym YearMonth) Month() time.Month { return ym.month }
+func (ym YearMonth) IsZero() bool      { return ym == YearMonth{} }
+
+func (ym YearMonth) String() string {
+	if ym.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d", ym.year, int(ym.month))
+}
+
+// Format retorna "10/2026" para pt-BR e en-US.
+func (ym YearMonth) Format(locale Locale) (string, error) {
+	if !locale.IsValid() {
+		message := fmt.Sprintf("Locale '%s' is not supported for year-month formatting.", locale)
+		return "", msg.NewValidationError(nil,
+			msg.ValidationContext{Field: "year_month", Input: string(locale), Constraint: "locale", Limit: []Locale{LocalePtBR, LocaleEnUS}}.ContextMap(),
+			message,
+		).WithContext(msg.KeyLocale, string(locale))
+	}
+	if ym.IsZero() {
+		return "", nil
+	}
+	return fmt.Sprintf("%02d/%04d", int(ym.month), ym.year), nil
+}
+
+func (ym YearMonth) AddMonths(n int) YearMonth {
+	return YearMonthOf(time.Date(ym.year, ym.month+time.Month(n), 1, 0, 0, 0, 0, time.UTC))
+}
+
+func (ym YearMonth) Next() YearMonth     { return ym.AddMonths(1) }
+func (ym YearMonth) Previous() YearMonth { return ym.AddMonths(-1) }
+
+func (ym YearMonth) Days() int {
+	return daysIn(ym.year, ym.month)
+}
+
+func (ym YearMonth) FirstDay() Date {
+	return Date{year: ym.year, month: ym.month, day: 1}
+}
+
+func (ym YearMonth) LastDay() Date {
+	return Date{year: ym.year, month: ym.month, day: ym.Days()}
+}
+
+func (ym YearMonth) Contains(d Date) bool {
+	return d.YearMonth() == ym
+}
+
+func (ym YearMonth) index() int {
+	return ym.year*12 + int(ym.month) - 1
+}
+
+// MonthsUntil retorna quantos meses separam ym de other; negativo se other for anterior.
+func (ym YearMonth) MonthsUntil(other YearMonth) int {
+	return other.index() - ym.index()
+}
+
+func (ym YearMonth) Compare(other YearMonth) int {
+	switch a, b := ym.index(), other.index(); {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (ym YearMonth) Before(other YearMonth) bool { return ym.Compare(other) < 0 }
+func (ym YearMonth) After(other YearMonth) bool  { return ym.Compare(other) > 0 }
+
+// YearMonthRange percorre as competências de from até to, inclusive; é vazio se from > to.
+func YearMonthRange(from, to YearMonth) iter.Seq[YearMonth] {
+	return func(yield func(YearMonth) bool) {
+		for ym := from; !ym.After(to); ym = ym.Next() {
+			if !yield(ym) {
+				return
+			}
+		}
+	}
+}
+
+// DateIn resolve o dia dentro da competência, limitando ao último dia do mês.
+func (d Day) DateIn(ym YearMonth) (Date, error) {
+	date, _, err := d.ResolveIn(ym, MonthEndClamp)
+	return date, err
+}
+
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	if ym.IsZero() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(ym.String())
+}
+
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*ym = YearMonth{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		message := fmt.Sprintf("YearMonth must be a JSON string in YYYY-MM format or 'null'; received '%s'.", string(data))
+		return msg.NewValidationError(err,
+			msg.ConversionContext{TargetType: "YearMonth", Source: msg.SourceJSON, Input: string(data)}.ContextMap(),
+			message,
+		)
+	}
+	parsed, err := ParseYearMonth(s)
+	if err != nil {
+		return err
+	}
+	*ym = parsed
+	return nil
+}
+
+// Value grava a competência como texto "2026-10".
+func (ym YearMonth) Value() (driver.Value, error) {
+	if ym.IsZero() {
+		return nil, nil
+	}
+	return ym.String(), nil
+}
+
+// Scan aceita texto "2026-10", colunas DATE (time.Time ou "2026-10-01") e inteiros AAAAMM.
+func (ym *YearMonth) Scan(src interface{}) error {
+	var raw string
+	switch v := src.(type) {
+	case nil:
+		*ym = YearMonth{}
+		return nil
+	case time.Time:
+		parsed, err := NewYearMonth(v.Year(), v.Month())
+		if err != nil {
+			return yearMonthScanError(err)
+		}
+		*ym = parsed
+		return nil
+	case int64:
+		parsed, err := NewYearMonth(int(v/100), time.Month(v%100))
+		if err != nil {
+			return yearMonthScanError(err)
+		}
+		*ym = parsed
+		return nil
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		message := fmt.Sprintf("Incompatible type (%T) for YearMonth. Expected string, []byte, time.Time or int64.", src)
+		return msg.NewValidationError(nil,
+			msg.ConversionContext{TargetType: "YearMonth", ReceivedType: fmt.Sprintf("%T", src), Source: msg.SourceDB}.ContextMap(),
+			message,
+		)
+	}
+	if len(raw) > len(YearMonthLayoutISO) && raw[len(YearMonthLayoutISO)] == '-' {
+		raw = raw[:len(YearMonthLayoutISO)]
+	}
+	parsed, err := ParseYearMonth(raw)
+	if err != nil {
+		return yearMonthScanError(err)
+	}
+	*ym = parsed
+	return nil
+}
+
+func yearMonthScanError(err error) error {
+	if msgErr, ok := err.(*msg.MessageError); ok {
+		msgErr.WithContext(msg.KeySource, msg.SourceDB)
+		return msgErr
+	}
+	return err
+}
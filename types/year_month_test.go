@@ -0,0 +1,182 @@
+package types_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marcelofabianov/gobrick/msg"
+	"github.com/marcelofabianov/gobrick/types"
+)
+
+func TestParseYearMonth(t *testing.T) {
+	expected := types.MustNewYearMonth(2026, time.October)
+	for _, input := range []string{"2026-10", "10/2026", " 10/2026 "} {
+		ym, err := types.ParseYearMonth(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, ym)
+	}
+
+	for _, input := range []string{"", "2026/10", "13/2026", "2026-1", "10-2026"} {
+		_, err := types.ParseYearMonth(input)
+		msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+		assert.Equal(t, "format", msgErr.Context[msg.KeyConstraint], input)
+	}
+
+	_, err := types.NewYearMonth(2026, 13)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "range", msgErr.Context[msg.KeyConstraint])
+}
+
+func TestYearMonth_YearZeroIsOutOfRange(t *testing.T) {
+	_, err := types.ParseYearMonth("0000-10")
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "range", msgErr.Context[msg.KeyConstraint])
+
+	_, err = types.ParseYearMonth("10/0000")
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "range", msgErr.Context[msg.KeyConstraint])
+
+	var decoded types.YearMonth
+	err = json.Unmarshal([]byte(`"0000-10"`), &decoded)
+	msgErr = requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "range", msgErr.Context[msg.KeyConstraint])
+	assert.True(t, decoded.IsZero())
+
+	for _, src := range []interface{}{"0000-10", []byte("0000-10-01"), time.Date(0, time.October, 1, 0, 0, 0, 0, time.UTC)} {
+		var scanned types.YearMonth
+		msgErr = requireMsgErrorCode(t, scanned.Scan(src), msg.CodeInvalid)
+		assert.Equal(t, "range", msgErr.Context[msg.KeyConstraint])
+		assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+		assert.True(t, scanned.IsZero())
+	}
+}
+
+func TestYearMonth_Format(t *testing.T) {
+	ym := types.MustNewYearMonth(2026, time.March)
+	assert.Equal(t, "2026-03", ym.String())
+
+	s, err := ym.Format(types.LocalePtBR)
+	require.NoError(t, err)
+	assert.Equal(t, "03/2026", s)
+
+	_, err = ym.Format(types.Locale("fr-FR"))
+	requireMsgErrorCode(t, err, msg.CodeInvalid)
+}
+
+func TestYearMonth_Navigation(t *testing.T) {
+	dec := types.MustNewYearMonth(2026, time.December)
+	assert.Equal(t, types.MustNewYearMonth(2027, time.January), dec.Next())
+	assert.Equal(t, types.MustNewYearMonth(2026, time.November), dec.Previous())
+	assert.Equal(t, types.MustNewYearMonth(2025, time.December), dec.AddMonths(-12))
+	assert.Equal(t, 14, dec.MonthsUntil(types.MustNewYearMonth(2028, time.February)))
+	assert.True(t, dec.Before(dec.Next()))
+	assert.True(t, dec.After(dec.Previous()))
+	assert.Equal(t, 0, dec.Compare(types.MustNewYearMonth(2026, time.December)))
+}
+
+func TestYearMonth_Days(t *testing.T) {
+	feb := types.MustNewYearMonth(2028, time.February)
+	assert.Equal(t, 29, feb.Days())
+	assert.Equal(t, 28, types.MustNewYearMonth(2026, time.February).Days())
+	assert.Equal(t, types.MustNewDate(2028, time.February, 1), feb.FirstDay())
+	assert.Equal(t, types.MustNewDate(2028, time.February, 29), feb.LastDay())
+	assert.True(t, feb.Contains(types.MustNewDate(2028, time.February, 15)))
+	assert.False(t, feb.Contains(types.MustNewDate(2027, time.February, 15)))
+	assert.Equal(t, feb, types.MustNewDate(2028, time.February, 15).YearMonth())
+}
+
+func TestYearMonthRange(t *testing.T) {
+	from := types.MustNewYearMonth(2026, time.November)
+	to := types.MustNewYearMonth(2027, time.February)
+
+	months := slices.Collect(types.YearMonthRange(from, to))
+	require.Len(t, months, 4)
+	assert.Equal(t, from, months[0])
+	assert.Equal(t, types.MustNewYearMonth(2027, time.January), months[2])
+	assert.Equal(t, to, months[3])
+
+	assert.Empty(t, slices.Collect(types.YearMonthRange(to, from)))
+
+	count := 0
+	for range types.YearMonthRange(from, to) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 2, count)
+}
+
+func TestDay_DateIn(t *testing.T) {
+	d, err := types.Day(31).DateIn(types.MustNewYearMonth(2026, time.February))
+	require.NoError(t, err)
+	assert.Equal(t, types.MustNewDate(2026, time.February, 28), d)
+
+	d, err = types.Day(10).DateIn(types.MustNewYearMonth(2026, time.October))
+	require.NoError(t, err)
+	assert.Equal(t, types.MustNewDate(2026, time.October, 10), d)
+
+	_, err = types.Day(0).DateIn(types.MustNewYearMonth(2026, time.October))
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "day", msgErr.Context[msg.KeyField])
+}
+
+func TestYearMonth_JSON(t *testing.T) {
+	type invoice struct {
+		Period types.YearMonth `json:"period"`
+	}
+	data, err := json.Marshal(invoice{Period: types.MustNewYearMonth(2026, time.October)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"period":"2026-10"}`, string(data))
+
+	var decoded invoice
+	require.NoError(t, json.Unmarshal([]byte(`{"period":"10/2026"}`), &decoded))
+	assert.Equal(t, types.MustNewYearMonth(2026, time.October), decoded.Period)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"period":null}`), &decoded))
+	assert.True(t, decoded.Period.IsZero())
+
+	err = json.Unmarshal([]byte(`{"period":202610}`), &decoded)
+	msgErr := requireMsgErrorCode(t, err, msg.CodeInvalid)
+	assert.Equal(t, "YearMonth", msgErr.Context[msg.KeyTargetType])
+}
+
+func TestYearMonth_SQL(t *testing.T) {
+	ym := types.MustNewYearMonth(2026, time.October)
+	value, err := ym.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10", value)
+
+	testCases := []struct {
+		name string
+		src  interface{}
+	}{
+		{"text", "2026-10"},
+		{"bytes", []byte("2026-10")},
+		{"date text", "2026-10-01"},
+		{"date", time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)},
+		{"yyyymm", int64(202610)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var scanned types.YearMonth
+			require.NoError(t, scanned.Scan(tc.src))
+			assert.Equal(t, ym, scanned)
+		})
+	}
+
+	var scanned types.YearMonth
+	require.NoError(t, scanned.Scan(nil))
+	assert.True(t, scanned.IsZero())
+
+	msgErr := requireMsgErrorCode(t, scanned.Scan(int64(202613)), msg.CodeInvalid)
+	assert.Equal(t, msg.SourceDB, msgErr.Context[msg.KeySource])
+
+	msgErr = requireMsgErrorCode(t, scanned.Scan(1.5), msg.CodeInvalid)
+	assert.Equal(t, "float64", msgErr.Context[msg.KeyReceivedType])
+}